@@ -0,0 +1,227 @@
+// Package apperr berisi error terstruktur untuk seluruh proyek: setiap error
+// punya kode (validation, not_found, internal), pesan, detail per field,
+// error penyebab yang bisa di-unwrap, dan stack trace jika diminta. Error
+// bisa ditampilkan sebagai JSON atau dicatat lewat log/slog. Panic yang
+// di-recover di paket mana pun menjadi PanicError.
+package apperr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"runtime"
+	"slices"
+	"strings"
+)
+
+// Code mengelompokkan error menurut jenisnya.
+type Code string
+
+const (
+	// CodeValidation: input dari pemanggil tidak valid.
+	CodeValidation Code = "validation"
+	// CodeNotFound: data yang diminta tidak ada.
+	CodeNotFound Code = "not_found"
+	// CodeInternal: kesalahan di dalam program, termasuk panic.
+	CodeInternal Code = "internal"
+)
+
+// Sentinel per kode, untuk errors.Is(err, apperr.ErrValidation) tanpa
+// memedulikan pesannya. WithField dan WithStack mengembalikan salinan,
+// sehingga memanggilnya pada sentinel tidak mengubah sentinel itu.
+var (
+	ErrValidation = &Error{Code: CodeValidation}
+	ErrNotFound   = &Error{Code: CodeNotFound}
+	ErrInternal   = &Error{Code: CodeInternal}
+)
+
+// Field adalah detail error untuk satu field input. Rule adalah nama aturan
+// yang dilanggar (misalnya "required"), berguna untuk menerjemahkan pesan.
+type Field struct {
+	Name    string `json:"name"`
+	Rule    string `json:"rule,omitempty"`
+	Message string `json:"message"`
+}
+
+// Error adalah error terstruktur. Buat dengan New, Newf atau Wrap.
+type Error struct {
+	Code    Code
+	Message string
+	Fields  []Field
+	Err     error     // penyebab, boleh nil
+	stack   []uintptr // diisi oleh WithStack
+}
+
+// New membuat Error dengan code dan msg.
+func New(code Code, msg string) *Error {
+	return &Error{Code: code, Message: msg}
+}
+
+// Newf seperti New dengan pesan yang diformat seperti fmt.Sprintf.
+func Newf(code Code, format string, args ...any) *Error {
+	return New(code, fmt.Sprintf(format, args...))
+}
+
+// Wrap membungkus err dengan code dan msg. Wrap(nil, ...) adalah nil.
+// errors.Is dan errors.As tetap bisa menemukan err.
+func Wrap(err error, code Code, msg string) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Code: code, Message: msg, Err: err}
+}
+
+// WithField mengembalikan salinan e dengan tambahan detail untuk field
+// name. e sendiri tidak berubah, jadi aman dipanggil pada sentinel atau
+// pada Error yang didapat lewat errors.As.
+func (e *Error) WithField(name, msg string) *Error {
+	c := *e
+	// Clip memaksa append menyalin agar Fields milik e tidak ikut terisi.
+	c.Fields = append(slices.Clip(e.Fields), Field{Name: name, Message: msg})
+	return &c
+}
+
+// WithStack mengembalikan salinan e yang menyimpan stack trace pemanggil
+// WithStack. e sendiri tidak berubah.
+func (e *Error) WithStack() *Error {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(2, pcs)
+	c := *e
+	c.stack = pcs[:n]
+	return &c
+}
+
+// Error menggabungkan pesan, detail field dan penyebab, misalnya
+// "input tidak valid (age: tidak boleh negatif)".
+func (e *Error) Error() string {
+	var b strings.Builder
+	b.WriteString(e.Message)
+	if b.Len() == 0 {
+		b.WriteString(string(e.Code))
+	}
+	if len(e.Fields) > 0 {
+		b.WriteString(" (")
+		for i, f := range e.Fields {
+			if i > 0 {
+				b.WriteString("; ")
+			}
+			fmt.Fprintf(&b, "%s: %s", f.Name, f.Message)
+		}
+		b.WriteString(")")
+	}
+	if e.Err != nil {
+		fmt.Fprintf(&b, ": %v", e.Err)
+	}
+	return b.String()
+}
+
+// Unwrap mengembalikan penyebab error.
+func (e *Error) Unwrap() error { return e.Err }
+
+// Is membuat errors.Is(err, ErrValidation) dan sentinel kode lainnya
+// cocok dengan semua Error berkode sama.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Message == "" && t.Err == nil && len(t.Fields) == 0 && t.Code == e.Code
+}
+
+// CodeOf mengembalikan kode Error pertama di rantai err, CodeInternal jika
+// err bukan Error, atau "" jika err nil.
+func CodeOf(err error) Code {
+	if err == nil {
+		return ""
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return CodeInternal
+}
+
+// Frames mengembalikan stack trace yang disimpan WithStack, atau nil.
+func (e *Error) Frames() []runtime.Frame {
+	if len(e.stack) == 0 {
+		return nil
+	}
+	var frames []runtime.Frame
+	it := runtime.CallersFrames(e.stack)
+	for {
+		f, more := it.Next()
+		frames = append(frames, f)
+		if !more {
+			return frames
+		}
+	}
+}
+
+// stackLines memformat Frames menjadi "fungsi (file:baris)".
+func (e *Error) stackLines() []string {
+	var lines []string
+	for _, f := range e.Frames() {
+		lines = append(lines, fmt.Sprintf("%s (%s:%d)", f.Function, f.File, f.Line))
+	}
+	return lines
+}
+
+// Format mendukung %s, %v, %q seperti Error, dan %+v yang menambahkan
+// stack trace per baris.
+func (e *Error) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		io.WriteString(s, e.Error())
+		if s.Flag('+') {
+			for _, line := range e.stackLines() {
+				io.WriteString(s, "\n\t"+line)
+			}
+		}
+	case 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	default:
+		io.WriteString(s, e.Error())
+	}
+}
+
+// ========== RENDERING ==========
+
+type jsonError struct {
+	Code    Code     `json:"code"`
+	Message string   `json:"message"`
+	Fields  []Field  `json:"fields,omitempty"`
+	Cause   any      `json:"cause,omitempty"`
+	Stack   []string `json:"stack,omitempty"`
+}
+
+// MarshalJSON menulis e sebagai objek JSON. Penyebab yang juga Error
+// ditulis sebagai objek bersarang, penyebab lain sebagai string.
+func (e *Error) MarshalJSON() ([]byte, error) {
+	j := jsonError{Code: e.Code, Message: e.Message, Fields: e.Fields, Stack: e.stackLines()}
+	var cause *Error
+	switch {
+	case errors.As(e.Err, &cause):
+		j.Cause = cause
+	case e.Err != nil:
+		j.Cause = e.Err.Error()
+	}
+	return json.Marshal(j)
+}
+
+// LogValue membuat slog menulis e sebagai grup berisi code, message,
+// field dan penyebab.
+func (e *Error) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("code", string(e.Code)),
+		slog.String("message", e.Message),
+	}
+	for _, f := range e.Fields {
+		attrs = append(attrs, slog.String("field."+f.Name, f.Message))
+	}
+	if e.Err != nil {
+		attrs = append(attrs, slog.String("cause", e.Err.Error()))
+	}
+	if len(e.stack) > 0 {
+		attrs = append(attrs, slog.Any("stack", e.stackLines()))
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,144 @@
+package apperr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestIsAs(t *testing.T) {
+	base := New(CodeNotFound, "user tidak ditemukan").WithField("id", "42")
+	err := fmt.Errorf("memuat profil: %w", Wrap(base, CodeInternal, "gagal memuat"))
+
+	for _, tt := range []struct {
+		target error
+		want   bool
+	}{
+		{ErrInternal, true},
+		{ErrNotFound, true}, // penyebab di dalam rantai
+		{ErrValidation, false},
+		{base, true},
+		{New(CodeNotFound, "user tidak ditemukan"), false}, // Error lain dengan pesan sama
+	} {
+		if got := errors.Is(err, tt.target); got != tt.want {
+			t.Errorf("errors.Is(err, %v) = %v, want %v", tt.target, got, tt.want)
+		}
+	}
+	if CodeOf(err) != CodeInternal || CodeOf(fs.ErrNotExist) != CodeInternal || CodeOf(nil) != "" {
+		t.Errorf("CodeOf = %q, %q, %q", CodeOf(err), CodeOf(fs.ErrNotExist), CodeOf(nil))
+	}
+	var e *Error
+	if !errors.As(err, &e) || e.Message != "gagal memuat" {
+		t.Errorf("errors.As = %+v", e)
+	}
+	if Wrap(nil, CodeInternal, "x") != nil {
+		t.Error("Wrap(nil) bukan nil")
+	}
+}
+
+func TestErrorString(t *testing.T) {
+	for _, tt := range []struct {
+		err  error
+		want string
+	}{
+		{New(CodeValidation, "input tidak valid"), "input tidak valid"},
+		{New(CodeValidation, "input tidak valid").WithField("age", "negatif").WithField("name", "kosong"),
+			"input tidak valid (age: negatif; name: kosong)"},
+		{Wrap(fs.ErrNotExist, CodeNotFound, "config"), "config: file does not exist"},
+		{ErrNotFound, "not_found"},
+	} {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("Error() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestRendering(t *testing.T) {
+	err := Wrap(New(CodeValidation, "umur salah").WithField("age", "negatif"), CodeInternal, "simpan gagal")
+
+	b, jerr := json.Marshal(err)
+	if jerr != nil {
+		t.Fatal(jerr)
+	}
+	want := `{"code":"internal","message":"simpan gagal","cause":{"code":"validation","message":"umur salah","fields":[{"name":"age","message":"negatif"}]}}`
+	if string(b) != want {
+		t.Errorf("JSON = %s\nwant   %s", b, want)
+	}
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Error("gagal", "err", err)
+	wantLog := `level=ERROR msg=gagal err.code=internal err.message="simpan gagal" err.cause="umur salah (age: negatif)"`
+	if got := strings.TrimSpace(buf.String()); got != wantLog {
+		t.Errorf("slog = %s\nwant   %s", got, wantLog)
+	}
+}
+
+func TestWithCopies(t *testing.T) {
+	v := ErrValidation.WithField("age", "negatif").WithStack()
+	if !errors.Is(v, ErrValidation) || len(v.Fields) != 1 || len(v.Frames()) == 0 {
+		t.Errorf("salinan = %+v", v)
+	}
+	if len(ErrValidation.Fields) != 0 || ErrValidation.Frames() != nil || ErrValidation.Error() != "validation" {
+		t.Errorf("sentinel berubah: %+v", ErrValidation)
+	}
+
+	// Target errors.As menunjuk ke Error yang sama dengan sentinel paket
+	// lain (seperti mathx.ErrDivisionByZero); WithField tidak mengubahnya.
+	var sentinel error = New(CodeValidation, "dibagi nol")
+	var target *Error
+	if errors.As(fmt.Errorf("hitung: %w", sentinel), &target) {
+		target.WithField("b", "nol")
+	}
+	if sentinel.Error() != "dibagi nol" {
+		t.Errorf("sentinel lewat errors.As berubah: %v", sentinel)
+	}
+
+	// Dua salinan dari Error yang sama tidak saling berbagi Fields.
+	base := New(CodeValidation, "tidak valid").WithField("a", "1")
+	x, y := base.WithField("b", "2"), base.WithField("c", "3")
+	if base.Error() != "tidak valid (a: 1)" || x.Error() != "tidak valid (a: 1; b: 2)" || y.Error() != "tidak valid (a: 1; c: 3)" {
+		t.Errorf("base = %v, x = %v, y = %v", base, x, y)
+	}
+}
+
+func TestWithStack(t *testing.T) {
+	err := New(CodeInternal, "meledak").WithStack()
+	frames := err.Frames()
+	if len(frames) == 0 || !strings.HasSuffix(frames[0].Function, "TestWithStack") {
+		t.Fatalf("Frames = %+v", frames)
+	}
+	if s := fmt.Sprintf("%+v", err); !strings.HasPrefix(s, "meledak\n\t") || !strings.Contains(s, "apperr_test.go") {
+		t.Errorf("%%+v = %s", s)
+	}
+	if s := fmt.Sprintf("%v", err); s != "meledak" {
+		t.Errorf("%%v = %s", s)
+	}
+}
+
+func TestRecovered(t *testing.T) {
+	cause := errors.New("akar masalah")
+	err := func() (err error) {
+		defer func() { err = Recovered(recover()) }()
+		panic(cause)
+	}()
+	var pe *PanicError
+	if !errors.As(err, &pe) || !errors.Is(err, cause) || err.Error() != "panic: akar masalah" {
+		t.Fatalf("Recovered = %v", err)
+	}
+	if !strings.Contains(string(pe.Stack), "TestRecovered") || CodeOf(err) != CodeInternal {
+		t.Errorf("Stack = %s, CodeOf = %q", pe.Stack, CodeOf(err))
+	}
+}
@@ -0,0 +1,30 @@
+package apperr
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// ========== PANIC ==========
+
+// PanicError adalah error hasil panic yang di-recover, dipakai bersama oleh
+// retry, supervisor, concurrency.Group dan cleanup sehingga pemanggil cukup
+// memeriksa satu tipe dengan errors.As. CodeOf-nya CodeInternal.
+type PanicError struct {
+	Value any    // nilai yang diberikan ke panic
+	Stack []byte // stack trace goroutine saat panic
+}
+
+// Recovered membuat PanicError dari v, nilai yang dikembalikan recover.
+// Panggil langsung di fungsi defer agar Stack masih memuat frame yang panic.
+func Recovered(v any) *PanicError {
+	return &PanicError{Value: v, Stack: debug.Stack()}
+}
+
+func (e *PanicError) Error() string { return fmt.Sprintf("panic: %v", e.Value) }
+
+// Unwrap mengembalikan Value jika Value adalah error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
@@ -0,0 +1,311 @@
+// Package breaker berisi circuit breaker untuk membungkus operasi yang
+// sering gagal, misalnya panggilan ke layanan lain. Setelah terlalu banyak
+// kegagalan, breaker "terbuka" dan langsung menolak panggilan selama masa
+// cool-down agar layanan sempat pulih, lalu membiarkan beberapa panggilan
+// percobaan lewat sebelum kembali normal.
+package breaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"learn-go/clock"
+)
+
+var (
+	// ErrOpen dikembalikan selama breaker terbuka.
+	ErrOpen = errors.New("breaker: sirkuit terbuka")
+	// ErrTooManyRequests dikembalikan saat half-open jika jumlah panggilan
+	// percobaan sudah mencapai Config.HalfOpenMax.
+	ErrTooManyRequests = errors.New("breaker: terlalu banyak panggilan percobaan")
+)
+
+// State adalah keadaan breaker.
+type State int
+
+const (
+	// Closed: panggilan diteruskan dan hasilnya dihitung.
+	Closed State = iota
+	// Open: panggilan langsung ditolak dengan ErrOpen sampai cool-down
+	// selesai.
+	Open
+	// HalfOpen: sejumlah kecil panggilan percobaan diteruskan untuk
+	// menguji apakah operasi sudah pulih.
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// Config mengatur Breaker. Nilai nol siap dipakai: terbuka setelah 5
+// kegagalan dalam 10 detik terakhir, cool-down 30 detik, satu panggilan
+// percobaan.
+type Config struct {
+	// Window adalah rentang rolling window untuk menghitung kegagalan,
+	// dibagi menjadi Buckets bagian. Bawaan 10 detik dan 10 bucket.
+	Window  time.Duration
+	Buckets int
+	// FailureThreshold membuka breaker jika jumlah kegagalan di window
+	// mencapai nilai ini.
+	FailureThreshold int
+	// FailureRatio membuka breaker jika rasio kegagalan di window mencapai
+	// nilai ini (0-1) dan jumlah panggilan paling sedikit MinRequests.
+	// Jika FailureThreshold dan FailureRatio nol, FailureThreshold = 5.
+	FailureRatio float64
+	MinRequests  int
+	// CoolDown adalah lama breaker terbuka sebelum menjadi half-open.
+	// Bawaan 30 detik.
+	CoolDown time.Duration
+	// HalfOpenMax adalah jumlah panggilan percobaan yang boleh berjalan
+	// bersamaan saat half-open. SuccessThreshold adalah jumlah percobaan
+	// berhasil yang dibutuhkan untuk kembali closed. Keduanya bawaan 1.
+	HalfOpenMax      int
+	SuccessThreshold int
+	// IsFailure memutuskan apakah error dihitung sebagai kegagalan. nil
+	// berarti semua error kecuali context.Canceled, karena pembatalan dari
+	// pemanggil bukan tanda operasinya rusak. Panggilan batal yang bukan
+	// kegagalan juga bukan keberhasilan: tidak masuk window dan tidak
+	// dihitung sebagai percobaan berhasil saat half-open.
+	IsFailure func(err error) bool
+	// OnStateChange dipanggil setiap keadaan berubah. Fungsi ini dipanggil
+	// sambil memegang kunci breaker sehingga tidak boleh memanggil method
+	// Breaker.
+	OnStateChange func(from, to State)
+	// Clock adalah sumber waktu. nil berarti clock.Real.
+	Clock clock.Clock
+}
+
+// Metrics adalah ringkasan statistik breaker.
+type Metrics struct {
+	State State
+	Since time.Time // waktu keadaan terakhir berubah
+
+	// Total sejak breaker dibuat.
+	Requests  uint64 // panggilan yang diteruskan
+	Successes uint64
+	Failures  uint64
+	Canceled  uint64 // panggilan batal yang tidak dihitung berhasil atau gagal
+	Rejected  uint64 // panggilan yang ditolak ErrOpen atau ErrTooManyRequests
+	Trips     uint64 // berapa kali breaker terbuka
+
+	// Isi rolling window saat ini.
+	WindowRequests int
+	WindowFailures int
+}
+
+// Breaker adalah circuit breaker. Breaker aman dipakai bersama oleh banyak
+// goroutine.
+type Breaker struct {
+	cfg Config
+	clk clock.Clock
+
+	mu         sync.Mutex
+	state      State
+	generation uint64 // naik setiap keadaan berubah
+	since      time.Time
+	window     *window
+	inFlight   int // panggilan percobaan saat half-open
+	trialOK    int // percobaan berhasil saat half-open
+	metrics    Metrics
+}
+
+// New membuat Breaker dalam keadaan closed.
+func New(cfg Config) *Breaker {
+	if cfg.Clock == nil {
+		cfg.Clock = clock.Real
+	}
+	if cfg.Window <= 0 {
+		cfg.Window = 10 * time.Second
+	}
+	if cfg.Buckets <= 0 {
+		cfg.Buckets = 10
+	}
+	if cfg.FailureThreshold <= 0 && cfg.FailureRatio <= 0 {
+		cfg.FailureThreshold = 5
+	}
+	if cfg.CoolDown <= 0 {
+		cfg.CoolDown = 30 * time.Second
+	}
+	cfg.HalfOpenMax = max(cfg.HalfOpenMax, 1)
+	cfg.SuccessThreshold = max(cfg.SuccessThreshold, 1)
+	if cfg.IsFailure == nil {
+		cfg.IsFailure = func(err error) bool {
+			return err != nil && !errors.Is(err, context.Canceled)
+		}
+	}
+	return &Breaker{
+		cfg:    cfg,
+		clk:    cfg.Clock,
+		since:  cfg.Clock.Now(),
+		window: newWindow(cfg.Window, cfg.Buckets),
+	}
+}
+
+// Do menjalankan fn jika breaker mengizinkan dan mencatat hasilnya. Jika
+// ditolak, fn tidak dipanggil dan Do mengembalikan ErrOpen atau
+// ErrTooManyRequests. Panic di dalam fn dicatat sebagai kegagalan lalu
+// diteruskan ke pemanggil.
+func (b *Breaker) Do(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	done, err := b.Allow()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			done(errPanic)
+			panic(r)
+		}
+	}()
+	err = fn(ctx)
+	done(err)
+	return err
+}
+
+// errPanic mewakili panic saat dicatat oleh Do.
+var errPanic = errors.New("breaker: panic")
+
+// Allow meminta izin untuk satu panggilan, untuk kode yang tidak bisa
+// dibungkus dengan Do. Jika diizinkan, done harus dipanggil tepat sekali
+// dengan hasil panggilan.
+func (b *Breaker) Allow() (done func(err error), err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advance(b.clk.Now())
+
+	switch b.state {
+	case Open:
+		b.metrics.Rejected++
+		return nil, ErrOpen
+	case HalfOpen:
+		if b.inFlight >= b.cfg.HalfOpenMax {
+			b.metrics.Rejected++
+			return nil, ErrTooManyRequests
+		}
+		b.inFlight++
+	}
+	b.metrics.Requests++
+
+	generation := b.generation
+	var once sync.Once
+	return func(err error) {
+		once.Do(func() { b.record(generation, err) })
+	}, nil
+}
+
+// record mencatat hasil panggilan yang diizinkan pada generation.
+func (b *Breaker) record(generation uint64, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.clk.Now()
+	b.advance(now)
+
+	failed := b.cfg.IsFailure(err)
+	canceled := !failed && errors.Is(err, context.Canceled)
+	switch {
+	case failed:
+		b.metrics.Failures++
+	case canceled:
+		b.metrics.Canceled++
+	default:
+		b.metrics.Successes++
+	}
+	// Hasil dari keadaan sebelumnya tidak boleh memengaruhi keadaan
+	// sekarang, misalnya panggilan lambat yang mulai sebelum breaker
+	// terbuka.
+	if generation != b.generation {
+		return
+	}
+	// Panggilan batal tidak punya hasil; cukup lepaskan slot percobaannya
+	// agar percobaan lain bisa masuk.
+	if canceled {
+		if b.state == HalfOpen {
+			b.inFlight--
+		}
+		return
+	}
+
+	switch b.state {
+	case Closed:
+		b.window.add(now, failed)
+		if b.tripped(now) {
+			b.setState(Open, now)
+		}
+	case HalfOpen:
+		b.inFlight--
+		if failed {
+			b.setState(Open, now)
+			return
+		}
+		b.trialOK++
+		if b.trialOK >= b.cfg.SuccessThreshold {
+			b.setState(Closed, now)
+		}
+	}
+}
+
+// tripped melaporkan apakah isi window sudah melewati ambang.
+func (b *Breaker) tripped(now time.Time) bool {
+	total, failures := b.window.counts(now)
+	if b.cfg.FailureThreshold > 0 && failures >= b.cfg.FailureThreshold {
+		return true
+	}
+	return b.cfg.FailureRatio > 0 && total > 0 && total >= b.cfg.MinRequests &&
+		float64(failures)/float64(total) >= b.cfg.FailureRatio
+}
+
+// advance memindahkan breaker dari open ke half-open jika cool-down sudah
+// selesai.
+func (b *Breaker) advance(now time.Time) {
+	if b.state == Open && !now.Before(b.since.Add(b.cfg.CoolDown)) {
+		b.setState(HalfOpen, now)
+	}
+}
+
+// setState mengganti keadaan dan mengosongkan hitungan keadaan lama.
+func (b *Breaker) setState(to State, now time.Time) {
+	from := b.state
+	b.state = to
+	b.since = now
+	b.generation++
+	b.inFlight = 0
+	b.trialOK = 0
+	b.window.reset()
+	if to == Open {
+		b.metrics.Trips++
+	}
+	if b.cfg.OnStateChange != nil {
+		b.cfg.OnStateChange(from, to)
+	}
+}
+
+// State mengembalikan keadaan breaker saat ini.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advance(b.clk.Now())
+	return b.state
+}
+
+// Metrics mengembalikan salinan statistik breaker.
+func (b *Breaker) Metrics() Metrics {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.clk.Now()
+	b.advance(now)
+	m := b.metrics
+	m.State = b.state
+	m.Since = b.since
+	m.WindowRequests, m.WindowFailures = b.window.counts(now)
+	return m
+}
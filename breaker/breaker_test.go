@@ -0,0 +1,248 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"learn-go/clock"
+)
+
+var errDown = errors.New("layanan mati")
+
+func newFake() *clock.Fake {
+	return clock.NewFake(time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC))
+}
+
+// call menjalankan satu panggilan lewat b yang menghasilkan err.
+func call(b *Breaker, err error) error {
+	return b.Do(context.Background(), func(context.Context) error { return err })
+}
+
+func TestTripAndRecover(t *testing.T) {
+	clk := newFake()
+	var changes []string
+	b := New(Config{
+		FailureThreshold: 3,
+		CoolDown:         5 * time.Second,
+		Clock:            clk,
+		OnStateChange:    func(from, to State) { changes = append(changes, fmt.Sprintf("%v->%v", from, to)) },
+	})
+
+	for range 3 {
+		if err := call(b, errDown); !errors.Is(err, errDown) {
+			t.Fatalf("Do = %v, want errDown", err)
+		}
+	}
+	if b.State() != Open {
+		t.Fatalf("State = %v setelah 3 kegagalan, want open", b.State())
+	}
+	called := false
+	err := b.Do(context.Background(), func(context.Context) error { called = true; return nil })
+	if !errors.Is(err, ErrOpen) || called {
+		t.Errorf("Do saat open = %v (fn dipanggil: %v), want ErrOpen", err, called)
+	}
+
+	clk.Advance(5*time.Second - time.Nanosecond)
+	if b.State() != Open {
+		t.Errorf("State = %v sebelum cool-down selesai", b.State())
+	}
+	clk.Advance(time.Nanosecond)
+	if b.State() != HalfOpen {
+		t.Errorf("State = %v setelah cool-down, want half-open", b.State())
+	}
+	if err := call(b, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"closed->open", "open->half-open", "half-open->closed"}
+	if !slices.Equal(changes, want) {
+		t.Errorf("perubahan = %v, want %v", changes, want)
+	}
+	m := b.Metrics()
+	if m.State != Closed || m.Requests != 4 || m.Failures != 3 || m.Successes != 1 || m.Rejected != 1 || m.Trips != 1 {
+		t.Errorf("Metrics = %+v", m)
+	}
+}
+
+func TestHalfOpen(t *testing.T) {
+	clk := newFake()
+	b := New(Config{FailureThreshold: 1, CoolDown: time.Second, HalfOpenMax: 2, SuccessThreshold: 2, Clock: clk})
+	call(b, errDown)
+	clk.Advance(time.Second)
+
+	// Dua percobaan boleh berjalan bersamaan, yang ketiga ditolak.
+	done1, err1 := b.Allow()
+	done2, err2 := b.Allow()
+	if _, err := b.Allow(); err1 != nil || err2 != nil || !errors.Is(err, ErrTooManyRequests) {
+		t.Fatalf("Allow = %v, %v, %v", err1, err2, err)
+	}
+	done1(nil)
+	if b.State() != HalfOpen {
+		t.Errorf("State = %v setelah 1 dari 2 percobaan berhasil", b.State())
+	}
+	// Satu percobaan gagal membuka breaker lagi.
+	done2(errDown)
+	done2(nil) // panggilan kedua diabaikan
+	if b.State() != Open {
+		t.Errorf("State = %v setelah percobaan gagal, want open", b.State())
+	}
+}
+
+func TestHalfOpenCanceled(t *testing.T) {
+	clk := newFake()
+	b := New(Config{FailureThreshold: 1, CoolDown: time.Second, Clock: clk})
+	call(b, errDown)
+	clk.Advance(time.Second)
+
+	// Percobaan yang batal tidak menutup breaker, tetapi slotnya dilepas.
+	if err := call(b, context.Canceled); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Do = %v, want context.Canceled", err)
+	}
+	if b.State() != HalfOpen {
+		t.Fatalf("State = %v setelah percobaan batal, want half-open", b.State())
+	}
+	if err := call(b, nil); err != nil {
+		t.Fatalf("Do setelah percobaan batal = %v, want slot tersedia", err)
+	}
+	if b.State() != Closed {
+		t.Errorf("State = %v setelah percobaan berhasil, want closed", b.State())
+	}
+	m := b.Metrics()
+	if m.Requests != 3 || m.Failures != 1 || m.Successes != 1 || m.Canceled != 1 {
+		t.Errorf("Metrics = %+v", m)
+	}
+}
+
+func TestRollingWindow(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		cfg   Config
+		calls []error // hasil panggilan berturut-turut
+		want  State
+	}{
+		{"ambang jumlah", Config{FailureThreshold: 3},
+			[]error{errDown, nil, errDown, nil, errDown}, Open},
+		{"rasio di bawah MinRequests", Config{FailureRatio: 0.5, MinRequests: 4},
+			[]error{errDown, errDown, errDown}, Closed},
+		{"rasio tercapai", Config{FailureRatio: 0.5, MinRequests: 4},
+			[]error{nil, errDown, nil, errDown}, Open},
+		{"pembatalan bukan kegagalan", Config{FailureThreshold: 2},
+			[]error{context.Canceled, context.Canceled, errDown}, Closed},
+		{"pembatalan tidak masuk rasio", Config{FailureRatio: 0.5, MinRequests: 2},
+			[]error{context.Canceled, context.Canceled, context.Canceled, nil, errDown}, Open},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			clk := newFake()
+			tt.cfg.Window = 10 * time.Second
+			tt.cfg.Clock = clk
+			b := New(tt.cfg)
+			for _, err := range tt.calls {
+				call(b, err)
+			}
+			if got := b.State(); got != tt.want {
+				t.Errorf("State = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestWindowExpiry memastikan kegagalan yang lebih tua dari Window tidak
+// dihitung lagi.
+func TestWindowExpiry(t *testing.T) {
+	clk := newFake()
+	b := New(Config{FailureThreshold: 3, Window: 10 * time.Second, Clock: clk})
+	call(b, errDown)
+	call(b, errDown)
+	clk.Advance(11 * time.Second)
+	call(b, errDown)
+	if m := b.Metrics(); m.State != Closed || m.WindowFailures != 1 {
+		t.Errorf("Metrics = %+v, want closed dengan 1 kegagalan di window", m)
+	}
+	call(b, errDown)
+	call(b, errDown)
+	if b.State() != Open {
+		t.Errorf("State = %v, want open", b.State())
+	}
+}
+
+// TestWindowBefore1970 memastikan nomor bucket yang negatif tetap
+// dipetakan ke bucket yang valid.
+func TestWindowBefore1970(t *testing.T) {
+	clk := clock.NewFake(time.Date(1960, time.January, 1, 0, 0, 3, 0, time.UTC))
+	b := New(Config{FailureThreshold: 3, Window: 10 * time.Second, Clock: clk})
+	call(b, errDown)
+	clk.Advance(time.Second)
+	call(b, errDown)
+	if m := b.Metrics(); m.WindowFailures != 2 {
+		t.Errorf("Metrics = %+v, want 2 kegagalan di window", m)
+	}
+	clk.Advance(11 * time.Second)
+	call(b, errDown)
+	if m := b.Metrics(); m.State != Closed || m.WindowFailures != 1 {
+		t.Errorf("Metrics = %+v, want closed dengan 1 kegagalan di window", m)
+	}
+}
+
+// TestStaleResult memastikan hasil panggilan yang mulai sebelum keadaan
+// berubah tidak memengaruhi keadaan baru.
+func TestStaleResult(t *testing.T) {
+	clk := newFake()
+	b := New(Config{FailureThreshold: 1, CoolDown: time.Second, Clock: clk})
+	slow, err := b.Allow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	call(b, errDown)
+	clk.Advance(time.Second) // half-open
+	slow(nil)
+	if b.State() != HalfOpen {
+		t.Errorf("State = %v, want half-open", b.State())
+	}
+}
+
+func TestDoPanic(t *testing.T) {
+	b := New(Config{FailureThreshold: 1, Clock: newFake()})
+	func() {
+		defer func() {
+			if r := recover(); r != "meledak" {
+				t.Errorf("recover = %v, want panic diteruskan", r)
+			}
+		}()
+		b.Do(context.Background(), func(context.Context) error { panic("meledak") })
+	}()
+	if b.State() != Open {
+		t.Errorf("State = %v, panic harus dihitung sebagai kegagalan", b.State())
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	clk := newFake()
+	b := New(Config{FailureRatio: 0.5, MinRequests: 20, CoolDown: time.Second, Clock: clk})
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				var err error
+				if (i+j)%3 == 0 {
+					err = errDown
+				}
+				call(b, err)
+				if j%10 == 0 {
+					clk.Advance(100 * time.Millisecond)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	m := b.Metrics()
+	if m.Requests+m.Rejected != 50*100 || m.Successes+m.Failures != m.Requests {
+		t.Errorf("Metrics tidak konsisten: %+v", m)
+	}
+}
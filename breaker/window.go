@@ -0,0 +1,66 @@
+package breaker
+
+import "time"
+
+// ========== ROLLING WINDOW ==========
+
+// window menghitung hasil panggilan dalam rentang waktu terakhir. Rentang
+// dibagi menjadi beberapa bucket; bucket yang sudah lewat dipakai ulang
+// sehingga memori tetap walau panggilan sangat banyak.
+type window struct {
+	width   time.Duration // lebar satu bucket
+	buckets []bucket
+}
+
+type bucket struct {
+	epoch     int64 // nomor bucket sejak Unix epoch
+	successes int
+	failures  int
+}
+
+func newWindow(size time.Duration, n int) *window {
+	width := max(size/time.Duration(n), time.Nanosecond)
+	return &window{width: width, buckets: make([]bucket, n)}
+}
+
+// epoch mengembalikan nomor bucket untuk now, dibulatkan ke bawah agar
+// waktu sebelum 1970 (UnixNano negatif) juga terbagi rata.
+func (w *window) epoch(now time.Time) int64 {
+	ns, width := now.UnixNano(), int64(w.width)
+	e := ns / width
+	if ns%width < 0 {
+		e--
+	}
+	return e
+}
+
+// add mencatat satu hasil di bucket untuk now.
+func (w *window) add(now time.Time, failed bool) {
+	e := w.epoch(now)
+	n := int64(len(w.buckets))
+	b := &w.buckets[(e%n+n)%n] // e bisa negatif sebelum 1970
+	if b.epoch != e {
+		*b = bucket{epoch: e}
+	}
+	if failed {
+		b.failures++
+	} else {
+		b.successes++
+	}
+}
+
+// counts menjumlahkan bucket yang masih berada di dalam window.
+func (w *window) counts(now time.Time) (total, failures int) {
+	e := w.epoch(now)
+	for _, b := range w.buckets {
+		if age := e - b.epoch; age >= 0 && age < int64(len(w.buckets)) {
+			total += b.successes + b.failures
+			failures += b.failures
+		}
+	}
+	return total, failures
+}
+
+func (w *window) reset() {
+	clear(w.buckets)
+}
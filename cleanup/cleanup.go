@@ -0,0 +1,167 @@
+// Package cleanup berisi Stack untuk melepas banyak resource dengan aman:
+// closer dijalankan dalam urutan LIFO seperti defer, semua error digabung
+// dengan errors.Join, dan kepemilikan resource bisa dipindahkan ke
+// pemanggil ketika fungsi berhasil.
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"learn-go/apperr"
+	"learn-go/clock"
+)
+
+// ErrTimeout dibungkus error closer yang tidak selesai dalam batas
+// waktunya.
+var ErrTimeout = errors.New("cleanup: waktu habis")
+
+type closer struct {
+	name    string
+	timeout time.Duration
+	fn      func(ctx context.Context) error
+}
+
+// Stack menyimpan closer yang dijalankan oleh Close, dimulai dari yang
+// terakhir didaftarkan. Nilai nol siap dipakai dan aman dipakai bersama
+// oleh banyak goroutine.
+//
+// Pola umumnya:
+//
+//	var s cleanup.Stack
+//	defer s.CloseInto(&err)
+//	f, err := os.Open(path)
+//	if err != nil {
+//		return err
+//	}
+//	s.PushCloser(path, f)
+type Stack struct {
+	mu      sync.Mutex
+	closers []closer
+	clk     clock.Clock // nil berarti clock.Real
+}
+
+// Push mendaftarkan fn dengan nama name untuk pesan error.
+func (s *Stack) Push(name string, fn func() error) {
+	s.PushContext(name, 0, func(context.Context) error { return fn() })
+}
+
+// PushCloser mendaftarkan c.Close.
+func (s *Stack) PushCloser(name string, c io.Closer) {
+	s.Push(name, c.Close)
+}
+
+// PushContext mendaftarkan fn yang menerima context dengan batas waktu
+// timeout, diukur dengan clock Stack sejak fn mulai dijalankan. Jika fn
+// belum selesai saat waktunya habis, ctx batal dengan context.Cause berisi
+// ErrTimeout, lalu Close mencatat ErrTimeout dan lanjut ke closer berikutnya
+// tanpa menunggu fn. timeout <= 0 berarti tanpa batas.
+func (s *Stack) PushContext(name string, timeout time.Duration, fn func(ctx context.Context) error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closers = append(s.closers, closer{name: name, timeout: timeout, fn: fn})
+}
+
+// SetClock mengganti sumber waktu untuk batas waktu PushContext. Bawaannya
+// clock.Real.
+func (s *Stack) SetClock(c clock.Clock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clk = c
+}
+
+// Len mengembalikan jumlah closer yang belum dijalankan.
+func (s *Stack) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.closers)
+}
+
+// Close menjalankan semua closer dalam urutan LIFO, termasuk jika closer
+// sebelumnya gagal atau panic, lalu mengembalikan gabungan semua error
+// (errors.Join) dengan awalan nama closer. Stack kosong setelah Close,
+// sehingga Close berikutnya tidak melakukan apa-apa.
+func (s *Stack) Close() error {
+	s.mu.Lock()
+	closers := s.closers
+	s.closers = nil
+	clk := s.clk
+	s.mu.Unlock()
+	if clk == nil {
+		clk = clock.Real
+	}
+
+	var errs []error
+	for i := len(closers) - 1; i >= 0; i-- {
+		c := closers[i]
+		if err := c.run(clk); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", c.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CloseInto memanggil Close dan menggabungkan error-nya ke *errp. Dipakai
+// dengan defer pada fungsi yang punya named return error, sehingga error
+// saat melepas resource tidak hilang. Closer tetap dijalankan walau fungsi
+// berhenti karena panic.
+func (s *Stack) CloseInto(errp *error) {
+	if err := s.Close(); err != nil {
+		*errp = errors.Join(*errp, err)
+	}
+}
+
+// Release memindahkan semua closer ke Stack baru, dengan clock yang sama,
+// dan mengosongkan s. Dipakai
+// ketika fungsi berhasil dan resource-nya diserahkan ke pemanggil: Close
+// yang di-defer pada s tidak melakukan apa-apa, dan pemanggil bertanggung
+// jawab memanggil Close pada Stack yang dikembalikan.
+func (s *Stack) Release() *Stack {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	owned := &Stack{closers: s.closers, clk: s.clk}
+	s.closers = nil
+	return owned
+}
+
+// run menjalankan closer dengan batas waktunya dan mengubah panic menjadi
+// *apperr.PanicError.
+func (c closer) run(clk clock.Clock) error {
+	if c.timeout <= 0 {
+		return c.call(context.Background())
+	}
+	// context.WithTimeout selalu memakai jam sistem, jadi batas waktunya
+	// diukur dengan timer dari clk lalu ctx dibatalkan secara manual.
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	timer := clk.NewTimer(c.timeout)
+	defer timer.Stop()
+	done := make(chan error, 1)
+	go func() { done <- c.call(ctx) }()
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C():
+		select {
+		case err := <-done: // fn selesai bersamaan dengan batas waktu
+			return err
+		default:
+		}
+		err := fmt.Errorf("%w setelah %v", ErrTimeout, c.timeout)
+		cancel(err)
+		return err
+	}
+}
+
+func (c closer) call(ctx context.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = apperr.Recovered(r)
+		}
+	}()
+	return c.fn(ctx)
+}
@@ -0,0 +1,126 @@
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"learn-go/apperr"
+	"learn-go/clock"
+)
+
+var errClose = errors.New("gagal menutup")
+
+func TestCloseOrderAndErrors(t *testing.T) {
+	var s Stack
+	var order []string
+	push := func(name string, err error) {
+		s.Push(name, func() error {
+			order = append(order, name)
+			return err
+		})
+	}
+	push("db", nil)
+	push("file", errClose)
+	s.Push("socket", func() error {
+		order = append(order, "socket")
+		panic("meledak")
+	})
+	push("cache", nil)
+
+	err := s.Close()
+	if want := []string{"cache", "socket", "file", "db"}; !slices.Equal(order, want) {
+		t.Errorf("urutan = %v, want %v", order, want)
+	}
+	if !errors.Is(err, errClose) {
+		t.Errorf("Close = %v, want errors.Is errClose", err)
+	}
+	if want := "socket: panic: meledak\nfile: gagal menutup"; err.Error() != want {
+		t.Errorf("Close = %q, want %q", err, want)
+	}
+	var pe *apperr.PanicError
+	if !errors.As(err, &pe) || pe.Value != "meledak" {
+		t.Errorf("Close = %v, want *apperr.PanicError meledak", err)
+	}
+	if err := s.Close(); err != nil || s.Len() != 0 {
+		t.Errorf("Close kedua = %v, Len = %d", err, s.Len())
+	}
+}
+
+func TestCloseIntoAfterPanic(t *testing.T) {
+	closed := false
+	var err error
+	func() {
+		defer func() { recover() }()
+		var s Stack
+		defer s.CloseInto(&err)
+		s.Push("file", func() error {
+			closed = true
+			return errClose
+		})
+		panic("gagal di tengah jalan")
+	}()
+	if !closed || !errors.Is(err, errClose) {
+		t.Errorf("closed = %v, err = %v", closed, err)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	clk := clock.NewFake(time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC))
+	var s Stack
+	s.SetClock(clk)
+	release := make(chan struct{})
+	defer close(release)
+	s.PushContext("lambat", 10*time.Millisecond, func(ctx context.Context) error {
+		<-release // tidak peduli ctx
+		return nil
+	})
+	s.PushContext("patuh", 10*time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+		return context.Cause(ctx)
+	})
+
+	errc := make(chan error, 1)
+	go func() { errc <- s.Close() }()
+	// Setiap closer punya batas waktu sendiri yang mulai saat ia dijalankan.
+	for range 2 {
+		clk.BlockUntil(1)
+		clk.Advance(10 * time.Millisecond)
+	}
+	err := <-errc
+	want := "patuh: cleanup: waktu habis setelah 10ms\nlambat: cleanup: waktu habis setelah 10ms"
+	if !errors.Is(err, ErrTimeout) || err.Error() != want {
+		t.Errorf("Close = %q, want %q", err, want)
+	}
+}
+
+// openBoth meniru fungsi yang membuka dua resource dan menyerahkannya ke
+// pemanggil jika berhasil.
+func openBoth(closed *[]string, fail bool) (owned *Stack, err error) {
+	var s Stack
+	defer s.CloseInto(&err)
+	s.Push("a", func() error { *closed = append(*closed, "a"); return nil })
+	if fail {
+		return nil, errors.New("b gagal dibuka")
+	}
+	s.Push("b", func() error { *closed = append(*closed, "b"); return nil })
+	return s.Release(), nil
+}
+
+func TestRelease(t *testing.T) {
+	var closed []string
+	if _, err := openBoth(&closed, true); err == nil || !slices.Equal(closed, []string{"a"}) {
+		t.Fatalf("gagal: err = %v, ditutup %v", err, closed)
+	}
+
+	closed = nil
+	owned, err := openBoth(&closed, false)
+	if err != nil || len(closed) != 0 {
+		t.Fatalf("berhasil: err = %v, ditutup %v sebelum diserahkan", err, closed)
+	}
+	if err := owned.Close(); err != nil || !slices.Equal(closed, []string{"b", "a"}) {
+		t.Errorf("owned.Close = %v, ditutup %v", err, closed)
+	}
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"learn-go/clock"
+	"learn-go/demo"
+	"learn-go/i18n"
+	"learn-go/progress"
+)
+
+// Exit code yang dipakai oleh CLI.
+const (
+	exitOK    = 0 // perintah berhasil
+	exitFail  = 1 // perintah gagal atau hasil validasi bernilai false
+	exitUsage = 2 // argumen atau flag tidak valid
+)
+
+// app menyimpan tujuan keluaran dan bahasa yang dipakai oleh semua
+// subcommand.
+type app struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	msg    *i18n.Printer
+	clock  clock.Clock // sumber waktu; clock.Real kecuali di test
+
+	history     *progress.History // dimuat saat pertama kali dibutuhkan
+	historyPath string
+}
+
+// run mem-parsing args dan menjalankan subcommand yang sesuai. Tanpa
+// argumen, TUI dijalankan jika stdin dan stdout terminal, dan menu
+// interaktif baris per baris jika tidak. Nilai kembalian adalah exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	a := &app{stdin: os.Stdin, stdout: stdout, stderr: stderr, msg: i18n.NewPrinter(i18n.FromEnv()), clock: clock.Real}
+
+	// --lang harus dibaca sebelum flag lain agar pesan bantuan sudah
+	// memakai bahasa yang dipilih.
+	if l, ok := langArg(args); ok {
+		locale, err := i18n.Parse(l)
+		if err != nil {
+			fmt.Fprintf(stderr, "learn-go: %v\n", err)
+			return exitUsage
+		}
+		a.msg = i18n.NewPrinter(locale)
+	}
+
+	fs := a.newFlagSet("learn-go", a.usage)
+	fs.String("lang", string(a.msg.Locale()), a.msg.T("cli.flag.lang"))
+	if code, ok := a.parseCommand(fs, args); !ok {
+		return code
+	}
+
+	if fs.NArg() == 0 {
+		if in, out, ok := a.terminal(); ok {
+			if err := a.tui(in, out); err != nil {
+				fmt.Fprintf(a.stderr, "learn-go: %v\n", err)
+				return exitFail
+			}
+			return exitOK
+		}
+		a.interactive()
+		return exitOK
+	}
+
+	cmd, rest := fs.Arg(0), fs.Args()[1:]
+	switch cmd {
+	case "help":
+		fs.SetOutput(stdout)
+		a.usage(fs)
+		return exitOK
+	case "tui":
+		return a.runTUI(rest)
+	case "menu":
+		a.interactive()
+		return exitOK
+	case "demo":
+		return a.runDemo(rest)
+	case "run":
+		return a.runRun(rest)
+	case "util":
+		return a.runUtil(rest)
+	case "wc":
+		return a.runWC(rest)
+	case "serve":
+		return a.runServe(rest)
+	case "exercise":
+		return a.runExercise(rest)
+	case "progress":
+		return a.runProgress(rest)
+	default:
+		fmt.Fprint(a.stderr, a.msg.Sprintf("cli.unknown_command", cmd))
+		a.usage(fs)
+		return exitUsage
+	}
+}
+
+// langArg mencari nilai --lang atau -lang sebelum subcommand.
+func langArg(args []string) (string, bool) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if len(arg) == 0 || arg[0] != '-' {
+			return "", false
+		}
+		for _, prefix := range []string{"--lang=", "-lang="} {
+			if len(arg) > len(prefix) && arg[:len(prefix)] == prefix {
+				return arg[len(prefix):], true
+			}
+		}
+		if (arg == "--lang" || arg == "-lang") && i+1 < len(args) {
+			return args[i+1], true
+		}
+	}
+	return "", false
+}
+
+// newFlagSet membuat FlagSet yang menulis ke stderr dan memakai usage
+// sebagai pesan bantuan.
+func (a *app) newFlagSet(name string, usage func(fs *flag.FlagSet)) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	fs.Usage = func() { usage(fs) }
+	return fs
+}
+
+// parse mem-parsing args subcommand ke fs. Flag boleh muncul sebelum atau
+// sesudah argumen posisi, misalnya "learn-go demo basic --section 2";
+// setelah "--" semua argumen dianggap argumen posisi. Argumen seperti "-5"
+// tetap argumen posisi, bukan flag. Nilai kedua false berarti subcommand
+// harus langsung berhenti dengan exit code pada nilai pertama.
+func (a *app) parse(fs *flag.FlagSet, args []string) (int, bool) {
+	var positional []string
+	for {
+		for len(args) > 0 && isNegativeNumber(args[0]) {
+			positional, args = append(positional, args[0]), args[1:]
+		}
+		if err := fs.Parse(args); err != nil {
+			return parseFailed(err)
+		}
+		rest := fs.Args()
+		if len(rest) == 0 {
+			break
+		}
+		if used := len(args) - len(rest); used > 0 && args[used-1] == "--" {
+			positional = append(positional, rest...)
+			break
+		}
+		positional, args = append(positional, rest[0]), rest[1:]
+	}
+	// Parse ulang hanya untuk menyimpan argumen posisi di fs.Args();
+	// nilai flag yang sudah terbaca tidak berubah.
+	fs.Parse(append([]string{"--"}, positional...))
+	return exitOK, true
+}
+
+// parseCommand mem-parsing flag global sampai nama subcommand, sehingga
+// flag setelahnya menjadi milik subcommand.
+func (a *app) parseCommand(fs *flag.FlagSet, args []string) (int, bool) {
+	if err := fs.Parse(args); err != nil {
+		return parseFailed(err)
+	}
+	return exitOK, true
+}
+
+func parseFailed(err error) (int, bool) {
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK, false
+	}
+	return exitUsage, false
+}
+
+// isNegativeNumber melaporkan apakah arg seperti "-5" atau "-1.5", yang
+// merupakan argumen posisi dan bukan flag.
+func isNegativeNumber(arg string) bool {
+	if len(arg) < 2 || arg[0] != '-' {
+		return false
+	}
+	_, err := strconv.ParseFloat(arg, 64)
+	return err == nil
+}
+
+func (a *app) usage(fs *flag.FlagSet) {
+	w := fs.Output()
+	fmt.Fprint(w, a.msg.T("cli.usage"))
+	fs.PrintDefaults()
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, a.msg.T("cli.categories"))
+	a.printDemoList(w)
+	fmt.Fprintln(w)
+	fmt.Fprint(w, a.msg.T("cli.exit_codes"))
+}
+
+func (a *app) runDemo(args []string) int {
+	fs := a.newFlagSet("demo", func(fs *flag.FlagSet) {
+		fmt.Fprintln(fs.Output(), a.msg.T("cli.demo.usage"))
+		fs.PrintDefaults()
+		fmt.Fprintln(fs.Output(), a.msg.T("cli.categories"))
+		a.printDemoList(fs.Output())
+	})
+	format := fs.String("format", "text", a.msg.T("cli.flag.format"))
+	section := fs.Int("section", 0, a.msg.T("cli.flag.section"))
+	seed := fs.Uint64("seed", 0, a.msg.T("cli.flag.seed"))
+	if code, ok := a.parse(fs, args); !ok {
+		return code
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	d, ok := demo.Lookup(fs.Arg(0))
+	if !ok {
+		fmt.Fprint(a.stderr, a.msg.Sprintf("cli.demo.unknown", fs.Arg(0)))
+		return exitUsage
+	}
+	out, err := a.newOutput(*format, *seed)
+	if err != nil {
+		fmt.Fprintf(a.stderr, "learn-go demo: %v\n", err)
+		return exitUsage
+	}
+
+	if *section == 0 {
+		d.Run(out)
+	} else if err := d.RunSection(out, *section); err != nil {
+		fmt.Fprintf(a.stderr, "learn-go demo: %v\n", err)
+		return exitUsage
+	}
+	a.recordRun(d.ID, *section)
+	return a.flushOutput(out)
+}
+
+func (a *app) runRun(args []string) int {
+	fs := a.newFlagSet("run", func(fs *flag.FlagSet) {
+		fmt.Fprintln(fs.Output(), a.msg.T("cli.run.usage"))
+		fs.PrintDefaults()
+	})
+	format := fs.String("format", "text", a.msg.T("cli.flag.format"))
+	seed := fs.Uint64("seed", 0, a.msg.T("cli.flag.seed"))
+	if code, ok := a.parse(fs, args); !ok {
+		return code
+	}
+	if fs.NArg() != 1 || fs.Arg(0) != "all" {
+		fs.Usage()
+		return exitUsage
+	}
+
+	out, err := a.newOutput(*format, *seed)
+	if err != nil {
+		fmt.Fprintf(a.stderr, "learn-go run: %v\n", err)
+		return exitUsage
+	}
+	demo.RunAll(out)
+	a.recordRunAll()
+	return a.flushOutput(out)
+}
+
+// newOutput membuat demo.Output ke stdout dari nilai flag --format dan
+// --seed, dengan bahasa yang sama seperti CLI.
+func (a *app) newOutput(format string, seed uint64) (*demo.Output, error) {
+	f, err := demo.ParseFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	out := demo.NewOutput(a.stdout, f)
+	out.SetLocale(a.msg.Locale())
+	if seed != 0 {
+		out.SetSeed(seed)
+	}
+	return out, nil
+}
+
+func (a *app) flushOutput(out *demo.Output) int {
+	if err := out.Flush(); err != nil {
+		fmt.Fprint(a.stderr, a.msg.Sprintf("cli.write_failed", err))
+		return exitFail
+	}
+	return exitOK
+}
+
+// printDemoList menampilkan semua demo terdaftar beserta kelompoknya.
+func (a *app) printDemoList(w io.Writer) {
+	l := a.msg.Locale()
+	for i, d := range demo.All() {
+		category := "[" + a.msg.T("demo.category."+d.Category) + "]"
+		fmt.Fprintf(w, "  %d. %-12s %-10s %s\n", i+1, d.ID, category, d.Title.In(l))
+		fmt.Fprintf(w, "     %s\n", d.Description.In(l))
+	}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"io/fs"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"learn-go/clock"
+	"learn-go/i18n"
+)
+
+// runCLI menjalankan run dengan args dan mengembalikan exit code beserta
+// isi stdout dan stderr. Bahasa dipaksa id lewat LC_ALL dan riwayat latihan
+// ditulis ke direktori sementara.
+func runCLI(t *testing.T, args ...string) (code int, stdout, stderr string) {
+	t.Helper()
+	t.Setenv("LC_ALL", "id")
+	t.Setenv("LEARN_GO_PROGRESS", filepath.Join(t.TempDir(), "progress.json"))
+	var out, errOut bytes.Buffer
+	code = run(args, &out, &errOut)
+	return code, out.String(), errOut.String()
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		code       int
+		stdout     string // harus terkandung di stdout
+		stderr     string // harus terkandung di stderr
+		emptyOut   bool   // stdout harus kosong
+		emptyError bool   // stderr harus kosong
+	}{
+		{name: "help", args: []string{"help"}, code: exitOK, stdout: "Exit code: 0 berhasil", emptyError: true},
+		{name: "--help", args: []string{"--help"}, code: exitOK, stderr: "Penggunaan:", emptyOut: true},
+		{name: "help en", args: []string{"--lang", "en", "help"}, code: exitOK, stdout: "Exit code: 0 success"},
+		{name: "perintah tidak dikenal", args: []string{"terbang"}, code: exitUsage, stderr: `perintah tidak dikenal "terbang"`, emptyOut: true},
+		{name: "bahasa tidak dikenal", args: []string{"--lang", "fr", "help"}, code: exitUsage, emptyOut: true},
+
+		{name: "util true", args: []string{"util", "nik", "3201234567890001"}, code: exitOK, stdout: "true\n", emptyError: true},
+		{name: "util false", args: []string{"util", "phone", "12345"}, code: exitFail, stdout: "false\n", emptyError: true},
+		{name: "util nilai", args: []string{"util", "format-number", "1234567"}, code: exitOK, stdout: "1,234,567\n"},
+		{name: "util angka negatif", args: []string{"util", "format-number", "-5"}, code: exitOK, stdout: "-5\n"},
+		{name: "util negatif tiga digit", args: []string{"util", "format-number", "-123"}, code: exitOK, stdout: "-123\n"},
+		{name: "util negatif ribuan", args: []string{"util", "format-number", "-1234567"}, code: exitOK, stdout: "-1,234,567\n"},
+		{name: "util bytes maksimum", args: []string{"util", "bytes", "9223372036854775807"}, code: exitOK, stdout: "8.0 EB\n"},
+		{name: "util argumen tidak valid", args: []string{"util", "format-number", "abc"}, code: exitUsage, stderr: `argumen tidak valid "abc"`, emptyOut: true},
+		{name: "util jumlah argumen salah", args: []string{"util", "nik"}, code: exitUsage, stderr: "Penggunaan: learn-go util nik", emptyOut: true},
+		{name: "util fungsi tidak dikenal", args: []string{"util", "terbang"}, code: exitUsage, stderr: `fungsi tidak dikenal "terbang"`, emptyOut: true},
+		{name: "flag tidak dikenal", args: []string{"demo", "--warna", "basic"}, code: exitUsage, stderr: "flag provided but not defined", emptyOut: true},
+
+		// Flag boleh ditulis setelah argumen posisi.
+		{name: "flag setelah kategori", args: []string{"demo", "basic", "--section", "1"}, code: exitOK, stdout: "1. Fungsi Sederhana:", emptyError: true},
+		{name: "--lang setelah subcommand", args: []string{"util", "--lang", "en", "nik", "1"}, code: exitUsage, stderr: "flag provided but not defined: -lang"},
+		{name: "setelah --", args: []string{"demo", "--", "basic", "--section", "1"}, code: exitUsage, emptyOut: true},
+		{name: "section tidak ada", args: []string{"demo", "basic", "--section", "99"}, code: exitUsage, stderr: "tidak memiliki section 99"},
+		{name: "wc --top negatif", args: []string{"wc", "--top", "-1", "cli.go"}, code: exitUsage, stderr: "--top tidak boleh negatif", emptyOut: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, stdout, stderr := runCLI(t, tt.args...)
+			if code != tt.code {
+				t.Errorf("exit code = %d, want %d\nstdout: %s\nstderr: %s", code, tt.code, stdout, stderr)
+			}
+			if !strings.Contains(stdout, tt.stdout) {
+				t.Errorf("stdout = %q, want berisi %q", stdout, tt.stdout)
+			}
+			if !strings.Contains(stderr, tt.stderr) {
+				t.Errorf("stderr = %q, want berisi %q", stderr, tt.stderr)
+			}
+			if tt.emptyOut && stdout != "" {
+				t.Errorf("stdout = %q, want kosong", stdout)
+			}
+			if tt.emptyError && stderr != "" {
+				t.Errorf("stderr = %q, want kosong", stderr)
+			}
+		})
+	}
+}
+
+func TestWC(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kata.txt")
+	if err := os.WriteFile(path, []byte("satu dua satu\ntiga\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	code, stdout, _ := runCLI(t, "wc", path, "--top", "1")
+	if code != exitOK || !strings.Contains(stdout, "2        4") || !strings.Contains(stdout, "1. satu") {
+		t.Errorf("wc = %d\n%s", code, stdout)
+	}
+
+	code, _, stderr := runCLI(t, "wc", filepath.Join(t.TempDir(), "tidak-ada.txt"))
+	if code != exitFail || !strings.Contains(stderr, "learn-go wc:") {
+		t.Errorf("wc file tidak ada = %d, stderr %q", code, stderr)
+	}
+
+	// Byte yang bukan UTF-8 hanya menjadi peringatan; barisnya tetap
+	// dihitung.
+	rusak := filepath.Join(t.TempDir(), "rusak.txt")
+	data := "satu\xff dua\n" + strings.Repeat("\xfe\n", 11)
+	if err := os.WriteFile(rusak, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	code, stdout, stderr = runCLI(t, "wc", rusak)
+	if code != exitOK || !strings.Contains(stdout, "12       13") {
+		t.Errorf("wc file rusak = %d\n%s", code, stdout)
+	}
+	if !strings.Contains(stderr, "rusak.txt:1:5: bukan UTF-8 yang valid") ||
+		strings.Count(stderr, "peringatan:") != 10 || !strings.Contains(stderr, "2 peringatan lain") {
+		t.Errorf("wc file rusak stderr = %q", stderr)
+	}
+}
+
+// TestSourcesEmbedded memastikan pola go:embed di tui_cmd.go memuat semua
+// file sumber paket dan tidak memuat file test.
+func TestSourcesEmbedded(t *testing.T) {
+	files, err := filepath.Glob("*/*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range files {
+		_, err := fs.Stat(sources, name)
+		switch test := strings.HasSuffix(name, "_test.go"); {
+		case test && err == nil:
+			t.Errorf("%s ikut di-embed", name)
+		case !test && err != nil:
+			t.Errorf("%s tidak di-embed: %v", name, err)
+		}
+	}
+}
+
+func TestServeMetrics(t *testing.T) {
+	a := &app{msg: i18n.NewPrinter(i18n.English), clock: clock.NewFake(time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC))}
+	h := a.serveHandler()
+	for _, body := range []string{`{"n":1234}`, `{"n":"x"}`} {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("POST", "/v1/format/number", strings.NewReader(body)))
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	got := rec.Body.String()
+	for _, want := range []string{
+		"# HELP playground_requests_total Number of playground requests.\n",
+		"playground_requests_total 2\n",
+		"playground_request_errors_total 1\n",
+		// Jam palsu tidak maju selama request, jadi lamanya tepat nol.
+		"playground_request_duration_seconds_sum 0\n",
+		"playground_request_duration_seconds_count 2\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("/metrics tidak berisi %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestHistoryUsesClock(t *testing.T) {
+	t.Setenv("LEARN_GO_PROGRESS", filepath.Join(t.TempDir(), "progress.json"))
+	now := time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
+	var errOut bytes.Buffer
+	a := &app{stderr: &errOut, msg: i18n.NewPrinter(i18n.Indonesian), clock: clock.NewFake(now)}
+	if err := a.saveRun("basic", 1); err != nil {
+		t.Fatal(err)
+	}
+	a.recordRunAll()
+	if errOut.Len() != 0 {
+		t.Fatalf("stderr = %q", errOut.String())
+	}
+	for i, r := range a.history.Runs {
+		if !r.At.Equal(now) {
+			t.Errorf("Runs[%d].At = %v, want %v", i, r.At, now)
+		}
+	}
+	if len(a.history.Runs) < 2 {
+		t.Errorf("Runs = %+v, want run basic dan semua demo", a.history.Runs)
+	}
+}
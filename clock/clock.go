@@ -0,0 +1,287 @@
+// Package clock membungkus sumber waktu agar kode yang bergantung pada
+// waktu bisa diuji tanpa menunggu. Kode produksi memakai Real, test memakai
+// Fake yang hanya bergerak ketika Advance dipanggil.
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock adalah sumber waktu. Semua method-nya setara dengan fungsi paket
+// time yang bernama sama.
+type Clock interface {
+	// Now mengembalikan waktu sekarang.
+	Now() time.Time
+	// Since mengembalikan waktu yang berlalu sejak t.
+	Since(t time.Time) time.Duration
+	// After mengirim waktu sekarang ke channel setelah d berlalu.
+	After(d time.Duration) <-chan time.Time
+	// Sleep menunggu sampai d berlalu.
+	Sleep(d time.Duration)
+	// NewTimer membuat Timer yang berbunyi sekali setelah d.
+	NewTimer(d time.Duration) Timer
+	// NewTicker membuat Ticker yang berbunyi setiap d. Panic jika d <= 0.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer seperti *time.Timer. Berbeda dari After, Timer bisa dihentikan
+// sehingga tidak tertinggal jika penunggunya berhenti lebih dulu.
+type Timer interface {
+	// C mengembalikan channel tempat waktu dikirim saat timer berbunyi.
+	C() <-chan time.Time
+	// Stop menghentikan timer. Nilai false berarti timer sudah berbunyi
+	// atau sudah dihentikan.
+	Stop() bool
+	// Reset menjalankan ulang timer agar berbunyi setelah d. Nilai
+	// kembaliannya sama seperti Stop sebelum Reset.
+	Reset(d time.Duration) bool
+}
+
+// Ticker seperti *time.Ticker: mengirim waktu setiap periode, dan
+// melewatkan bunyi jika penerimanya tertinggal.
+type Ticker interface {
+	// C mengembalikan channel tempat waktu dikirim setiap periode.
+	C() <-chan time.Time
+	// Stop menghentikan ticker.
+	Stop()
+	// Reset mengganti periode ticker menjadi d, dihitung dari sekarang.
+	Reset(d time.Duration)
+}
+
+// Real adalah Clock yang memakai jam sistem.
+var Real Clock = realClock{}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) Since(t time.Time) time.Duration        { return time.Since(t) }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+func (realClock) Sleep(d time.Duration)                  { time.Sleep(d) }
+func (realClock) NewTimer(d time.Duration) Timer         { return realTimer{time.NewTimer(d)} }
+func (realClock) NewTicker(d time.Duration) Ticker       { return realTicker{time.NewTicker(d)} }
+
+type realTimer struct{ t *time.Timer }
+
+func (r realTimer) C() <-chan time.Time        { return r.t.C }
+func (r realTimer) Stop() bool                 { return r.t.Stop() }
+func (r realTimer) Reset(d time.Duration) bool { return r.t.Reset(d) }
+
+type realTicker struct{ t *time.Ticker }
+
+func (r realTicker) C() <-chan time.Time   { return r.t.C }
+func (r realTicker) Stop()                 { r.t.Stop() }
+func (r realTicker) Reset(d time.Duration) { r.t.Reset(d) }
+
+// ========== FAKE CLOCK ==========
+
+// Fake adalah Clock yang hanya bergerak lewat Advance. Fake aman dipakai
+// dari banyak goroutine.
+type Fake struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*waiter
+	changed chan struct{} // ditutup dan diganti setiap jumlah waiter berubah
+}
+
+// waiter adalah After, Timer atau Ticker yang belum jatuh tempo. Ticker
+// punya period > 0 dan dijadwalkan ulang setiap kali berbunyi.
+type waiter struct {
+	at     time.Time
+	period time.Duration
+	ch     chan time.Time
+}
+
+// NewFake membuat Fake yang menunjukkan waktu now.
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now, changed: make(chan struct{})}
+}
+
+// Now mengembalikan waktu Fake saat ini.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Since mengembalikan selisih waktu Fake saat ini dengan t.
+func (f *Fake) Since(t time.Time) time.Duration {
+	return f.Now().Sub(t)
+}
+
+// After mengembalikan channel yang menerima waktu setelah Fake dimajukan
+// paling sedikit d. d <= 0 langsung terkirim.
+func (f *Fake) After(d time.Duration) <-chan time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w := &waiter{ch: make(chan time.Time, 1)}
+	f.schedule(w, d)
+	return w.ch
+}
+
+// Sleep menunggu sampai goroutine lain memajukan Fake paling sedikit d.
+func (f *Fake) Sleep(d time.Duration) {
+	<-f.After(d)
+}
+
+// NewTimer membuat Timer yang berbunyi setelah Fake dimajukan d.
+func (f *Fake) NewTimer(d time.Duration) Timer {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	t := &fakeTimer{f: f, w: &waiter{ch: make(chan time.Time, 1)}}
+	f.schedule(t.w, d)
+	return t
+}
+
+// NewTicker membuat Ticker yang berbunyi setiap kali Fake melewati
+// kelipatan d. Panic jika d <= 0, sama seperti time.NewTicker.
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("clock: periode Ticker harus positif")
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	t := &fakeTimer{f: f, w: &waiter{period: d, ch: make(chan time.Time, 1)}}
+	f.schedule(t.w, d)
+	return fakeTicker{t}
+}
+
+// Advance memajukan waktu sebesar d dan membangunkan semua After, Timer
+// dan Ticker yang jatuh temponya sudah lewat, urut dari yang paling awal.
+// Setiap channel menerima waktu jatuh temponya. Ticker yang terlewat
+// beberapa kali hanya menyimpan satu bunyi, seperti time.Ticker.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	target := f.now.Add(d)
+
+	fired := false
+	for len(f.waiters) > 0 {
+		sort.SliceStable(f.waiters, func(i, j int) bool { return f.waiters[i].at.Before(f.waiters[j].at) })
+		w := f.waiters[0]
+		if w.at.After(target) {
+			break
+		}
+		f.now = w.at
+		select {
+		case w.ch <- w.at:
+		default: // penerima ticker tertinggal; bunyi dilewatkan
+		}
+		if w.period > 0 {
+			w.at = w.at.Add(w.period)
+			continue
+		}
+		f.waiters = f.waiters[1:]
+		fired = true
+	}
+	f.now = target
+	if fired {
+		f.notify()
+	}
+}
+
+// Waiters mengembalikan jumlah After, Timer dan Ticker yang belum jatuh
+// tempo. Ticker dihitung sampai dihentikan.
+func (f *Fake) Waiters() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.waiters)
+}
+
+// BlockUntil menunggu sampai ada tepat n waiter (lihat Waiters), misalnya
+// untuk memastikan goroutine lain sudah mulai menunggu sebelum test
+// memanggil Advance.
+func (f *Fake) BlockUntil(n int) {
+	for {
+		f.mu.Lock()
+		if len(f.waiters) == n {
+			f.mu.Unlock()
+			return
+		}
+		changed := f.changed
+		f.mu.Unlock()
+		<-changed
+	}
+}
+
+// schedule menjadwalkan w berbunyi d dari sekarang, atau langsung jika
+// d <= 0 dan w bukan ticker. Dipanggil sambil memegang f.mu.
+func (f *Fake) schedule(w *waiter, d time.Duration) {
+	if d <= 0 && w.period == 0 {
+		select {
+		case w.ch <- f.now:
+		default:
+		}
+		return
+	}
+	w.at = f.now.Add(d)
+	f.waiters = append(f.waiters, w)
+	f.notify()
+}
+
+// remove membatalkan w. Nilai false berarti w tidak sedang menunggu.
+// Dipanggil sambil memegang f.mu.
+func (f *Fake) remove(w *waiter) bool {
+	for i, x := range f.waiters {
+		if x == w {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			f.notify()
+			return true
+		}
+	}
+	return false
+}
+
+// notify membangunkan BlockUntil. Dipanggil sambil memegang f.mu.
+func (f *Fake) notify() {
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+// fakeTimer adalah Timer milik Fake, juga dipakai di balik fakeTicker.
+type fakeTimer struct {
+	f *Fake
+	w *waiter
+}
+
+func (t *fakeTimer) C() <-chan time.Time { return t.w.ch }
+
+// Stop membatalkan jadwal dan membuang bunyi yang belum dibaca, seperti
+// time.Timer sejak Go 1.23.
+func (t *fakeTimer) Stop() bool {
+	t.f.mu.Lock()
+	defer t.f.mu.Unlock()
+	return t.stop()
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.f.mu.Lock()
+	defer t.f.mu.Unlock()
+	active := t.stop()
+	if t.w.period > 0 {
+		if d <= 0 {
+			panic("clock: periode Ticker harus positif")
+		}
+		t.w.period = d
+	}
+	t.f.schedule(t.w, d)
+	return active
+}
+
+func (t *fakeTimer) stop() bool {
+	active := t.f.remove(t.w)
+	select {
+	case <-t.w.ch:
+	default:
+	}
+	return active
+}
+
+// fakeTicker membuat *fakeTimer memenuhi Ticker, yang Stop dan Reset-nya
+// tidak mengembalikan nilai.
+type fakeTicker struct{ t *fakeTimer }
+
+func (t fakeTicker) C() <-chan time.Time   { return t.t.C() }
+func (t fakeTicker) Stop()                 { t.t.Stop() }
+func (t fakeTicker) Reset(d time.Duration) { t.t.Reset(d) }
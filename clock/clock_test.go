@@ -0,0 +1,96 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeAfter(t *testing.T) {
+	start := time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
+	f := NewFake(start)
+	late, early := f.After(2*time.Second), f.After(time.Second)
+	if f.Waiters() != 2 {
+		t.Fatalf("Waiters = %d, want 2", f.Waiters())
+	}
+
+	f.Advance(time.Second)
+	select {
+	case got := <-early:
+		if !got.Equal(start.Add(time.Second)) {
+			t.Errorf("After(1s) = %v", got)
+		}
+	default:
+		t.Fatal("After(1s) belum terkirim")
+	}
+	select {
+	case <-late:
+		t.Fatal("After(2s) terkirim terlalu cepat")
+	default:
+	}
+
+	f.Advance(time.Second)
+	<-late
+	if f.Waiters() != 0 || !f.Now().Equal(start.Add(2*time.Second)) {
+		t.Errorf("Waiters = %d, Now = %v", f.Waiters(), f.Now())
+	}
+	select {
+	case <-f.After(0):
+	default:
+		t.Error("After(0) tidak langsung terkirim")
+	}
+}
+
+func TestFakeTimer(t *testing.T) {
+	start := time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
+	f := NewFake(start)
+	timer := f.NewTimer(time.Second)
+	if !timer.Stop() || timer.Stop() || f.Waiters() != 0 {
+		t.Fatalf("Stop pertama harus true, kedua false; Waiters = %d", f.Waiters())
+	}
+	f.Advance(2 * time.Second)
+	select {
+	case <-timer.C():
+		t.Fatal("timer yang dihentikan berbunyi")
+	default:
+	}
+
+	if timer.Reset(time.Second) {
+		t.Error("Reset timer yang tidak aktif = true")
+	}
+	f.Advance(time.Second)
+	if got := <-timer.C(); !got.Equal(start.Add(3 * time.Second)) {
+		t.Errorf("timer berbunyi pada %v", got)
+	}
+}
+
+func TestFakeTickerAndSleep(t *testing.T) {
+	start := time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
+	f := NewFake(start)
+	ticker := f.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	f.Advance(1500 * time.Millisecond)
+	if got := <-ticker.C(); !got.Equal(start.Add(time.Second)) {
+		t.Errorf("tick pertama = %v", got)
+	}
+	// Tiga periode terlewat tanpa dibaca: hanya satu tick yang tersimpan.
+	f.Advance(3 * time.Second)
+	<-ticker.C()
+	select {
+	case <-ticker.C():
+		t.Error("tick yang terlewat tidak dilewatkan")
+	default:
+	}
+
+	done := make(chan struct{})
+	go func() {
+		f.Sleep(time.Minute)
+		close(done)
+	}()
+	f.BlockUntil(2) // ticker dan Sleep
+	f.Advance(time.Minute)
+	<-done
+	if got := f.Since(start); got != time.Minute+4500*time.Millisecond {
+		t.Errorf("Since = %v", got)
+	}
+}
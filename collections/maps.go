@@ -0,0 +1,53 @@
+package collections
+
+// ========== FUNGSI DENGAN MAP ==========
+
+// MergeMaps menggabungkan map1 dan map2. Nilai untuk key yang sama
+// dijumlahkan.
+func MergeMaps(map1, map2 map[string]int) map[string]int {
+	result := make(map[string]int)
+
+	for key, value := range map1 {
+		result[key] = value
+	}
+
+	for key, value := range map2 {
+		result[key] += value
+	}
+
+	return result
+}
+
+// Keys mengembalikan semua key dari m dalam urutan acak.
+func Keys(m map[string]int) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values mengembalikan semua value dari m dalam urutan acak.
+func Values(m map[string]int) []int {
+	var values []int
+	for _, value := range m {
+		values = append(values, value)
+	}
+	return values
+}
+
+// ReverseMap membalik key-value dalam m. Jika ada value yang sama, key yang
+// tersimpan tidak ditentukan.
+func ReverseMap(m map[string]int) map[int]string {
+	result := make(map[int]string)
+	for key, value := range m {
+		result[value] = key
+	}
+	return result
+}
+
+// HasKey mengecek apakah m memiliki key.
+func HasKey(m map[string]int, key string) bool {
+	_, exists := m[key]
+	return exists
+}
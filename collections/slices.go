@@ -0,0 +1,124 @@
+// Package collections berisi fungsi-fungsi untuk bekerja dengan slice dan map.
+package collections
+
+// ========== FUNGSI DENGAN SLICE ==========
+
+// FindMax mengembalikan nilai maksimum dalam numbers, atau 0 jika kosong.
+func FindMax(numbers []int) int {
+	if len(numbers) == 0 {
+		return 0
+	}
+	max := numbers[0]
+	for _, num := range numbers {
+		if num > max {
+			max = num
+		}
+	}
+	return max
+}
+
+// FindMin mengembalikan nilai minimum dalam numbers, atau 0 jika kosong.
+func FindMin(numbers []int) int {
+	if len(numbers) == 0 {
+		return 0
+	}
+	min := numbers[0]
+	for _, num := range numbers {
+		if num < min {
+			min = num
+		}
+	}
+	return min
+}
+
+// Average menghitung rata-rata numbers, atau 0 jika kosong.
+func Average(numbers []int) float64 {
+	if len(numbers) == 0 {
+		return 0
+	}
+	sum := 0
+	for _, num := range numbers {
+		sum += num
+	}
+	return float64(sum) / float64(len(numbers))
+}
+
+// Filter mengembalikan elemen numbers yang memenuhi condition.
+func Filter(numbers []int, condition func(int) bool) []int {
+	var result []int
+	for _, num := range numbers {
+		if condition(num) {
+			result = append(result, num)
+		}
+	}
+	return result
+}
+
+// RemoveDuplicates menghapus duplikasi dengan mempertahankan urutan
+// kemunculan pertama.
+func RemoveDuplicates(slice []int) []int {
+	keys := make(map[int]bool)
+	var result []int
+
+	for _, item := range slice {
+		if !keys[item] {
+			keys[item] = true
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// Contains mengecek apakah slice mengandung item.
+func Contains(slice []int, item int) bool {
+	for _, element := range slice {
+		if element == item {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateRange mengembalikan angka dari start sampai end (inklusif).
+func GenerateRange(start, end int) []int {
+	var result []int
+	for i := start; i <= end; i++ {
+		result = append(result, i)
+	}
+	return result
+}
+
+// ========== REKURSI PADA SLICE ==========
+
+// SumArray menjumlahkan elemen arr secara rekursif.
+func SumArray(arr []int) int {
+	if len(arr) == 0 {
+		return 0
+	}
+	if len(arr) == 1 {
+		return arr[0]
+	}
+	return arr[0] + SumArray(arr[1:])
+}
+
+// BinarySearch mencari target dalam arr yang sudah terurut dan mengembalikan
+// indeksnya, atau -1 jika tidak ditemukan.
+func BinarySearch(arr []int, target int) int {
+	return binarySearch(arr, target, 0, len(arr)-1)
+}
+
+func binarySearch(arr []int, target, left, right int) int {
+	if left > right {
+		return -1 // tidak ditemukan
+	}
+
+	mid := left + (right-left)/2
+
+	if arr[mid] == target {
+		return mid
+	} else if arr[mid] > target {
+		return binarySearch(arr, target, left, mid-1)
+	} else {
+		return binarySearch(arr, target, mid+1, right)
+	}
+}
@@ -0,0 +1,48 @@
+package concurrency
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"learn-go/clock"
+)
+
+// ========== FUNGSI DENGAN MUTEX ==========
+
+// SafeCounter adalah counter yang aman dipakai dari banyak goroutine.
+// Zero value siap dipakai. Setiap Increment mengunci satu mutex, sehingga
+// menjadi titik rebutan jika ratusan goroutine menambah bersamaan; untuk
+// kasus itu pakai metrics.Counter atau metrics.ShardedCounter.
+type SafeCounter struct {
+	mu    sync.Mutex
+	value int
+}
+
+// Increment menaikkan counter sebanyak satu.
+func (c *SafeCounter) Increment() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.value++
+}
+
+// Value mengembalikan nilai counter saat ini.
+func (c *SafeCounter) Value() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.value
+}
+
+// IncrementCounter menaikkan counter sebanyak times kali, dengan jeda 1ms
+// menurut clk di antaranya, lalu menandai wg selesai. Jika ctx batal,
+// IncrementCounter berhenti dan penambahan yang tersisa dilewatkan.
+func IncrementCounter(ctx context.Context, clk clock.Clock, counter *SafeCounter, times int, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	for i := 0; i < times; i++ {
+		counter.Increment()
+		if err := sleep(ctx, clk, time.Millisecond); err != nil {
+			return err
+		}
+	}
+	return nil
+}
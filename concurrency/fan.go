@@ -0,0 +1,38 @@
+package concurrency
+
+import (
+	"context"
+
+	"learn-go/pipeline"
+)
+
+// ========== FAN-IN FAN-OUT PATTERN ==========
+
+// Fungsi di bawah ini dipertahankan agar kode lama tetap terkompilasi.
+// Masing-masing berjalan di pipeline sendiri tanpa pembatalan, jadi channel
+// hasilnya harus dibaca sampai habis agar goroutine-nya selesai.
+
+// Generator mengirim nums ke channel yang dikembalikan lalu menutupnya.
+//
+// Deprecated: gunakan pipeline.Source, yang bisa dibatalkan lewat context.
+func Generator(nums ...int) <-chan int {
+	return pipeline.Source(pipeline.New(context.Background()), nums...)
+}
+
+// Square mengkuadratkan setiap nilai dari in. Beberapa Square yang membaca
+// dari channel yang sama membentuk fan-out.
+//
+// Deprecated: gunakan pipeline.Map, atau pipeline.FanOut untuk fan-out.
+func Square(in <-chan int) <-chan int {
+	return pipeline.Map(pipeline.New(context.Background()), in, func(_ context.Context, n int) (int, error) {
+		return n * n, nil
+	})
+}
+
+// FanIn menggabungkan beberapa channel menjadi satu. Channel hasil ditutup
+// setelah semua input ditutup.
+//
+// Deprecated: gunakan pipeline.Merge.
+func FanIn(channels ...<-chan int) <-chan int {
+	return pipeline.Merge(pipeline.New(context.Background()), channels...)
+}
@@ -0,0 +1,197 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"learn-go/apperr"
+	"learn-go/clock"
+)
+
+// ========== TASK GROUP ==========
+
+// GroupConfig mengatur Group. Nilai nol siap dipakai: task tanpa batas
+// paralel, dan error pertama membatalkan task lain.
+type GroupConfig struct {
+	// Limit adalah jumlah task yang boleh berjalan bersamaan; <= 0 berarti
+	// tanpa batas.
+	Limit int
+	// CollectAll membuat error task tidak membatalkan task lain. Wait
+	// mengembalikan gabungan semua error, bukan hanya yang pertama.
+	CollectAll bool
+	// Clock dipakai untuk mengukur durasi task. nil berarti clock.Real.
+	Clock clock.Clock
+}
+
+// TaskResult adalah hasil satu task di Group.
+type TaskResult struct {
+	Name     string
+	Err      error
+	Duration time.Duration
+	// Skipped bernilai true jika task tidak sempat dijalankan karena group
+	// sudah batal; Err-nya berisi error context.
+	Skipped bool
+}
+
+// Group menjalankan sekumpulan task func(ctx) error dan menunggu semuanya
+// selesai, seperti sync.WaitGroup yang bisa mengembalikan error. Berbeda
+// dari TaskWithWaitGroup, Group membatasi jumlah task yang berjalan
+// bersamaan, membatalkan task lain setelah error pertama, dan mengubah
+// panic menjadi *apperr.PanicError. Buat dengan NewGroup.
+type Group struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	clk        clock.Clock
+	collectAll bool
+	sem        chan struct{} // nil jika tanpa batas
+	wg         sync.WaitGroup
+
+	mu    sync.Mutex
+	tasks []TaskResult
+	first int // indeks task yang gagal pertama, -1 jika belum ada
+}
+
+// NewGroup membuat Group yang task-nya menerima context turunan ctx.
+// Context itu batal ketika ctx batal, ketika task pertama gagal (kecuali
+// cfg.CollectAll), atau ketika Wait kembali.
+func NewGroup(ctx context.Context, cfg GroupConfig) *Group {
+	ctx, cancel := context.WithCancel(ctx)
+	g := &Group{ctx: ctx, cancel: cancel, clk: cfg.Clock, collectAll: cfg.CollectAll, first: -1}
+	if g.clk == nil {
+		g.clk = clock.Real
+	}
+	if cfg.Limit > 0 {
+		g.sem = make(chan struct{}, cfg.Limit)
+	}
+	return g
+}
+
+// Context mengembalikan context yang diterima task.
+func (g *Group) Context() context.Context {
+	return g.ctx
+}
+
+// Go menjalankan fn di goroutine baru dengan nama name untuk laporan. Jika
+// sudah ada Limit task yang berjalan, Go menunggu sampai ada yang selesai.
+// Task yang belum mulai ketika group batal tidak dijalankan dan dicatat
+// Skipped. Go tidak boleh dipanggil setelah Wait.
+func (g *Group) Go(name string, fn func(ctx context.Context) error) {
+	g.mu.Lock()
+	i := len(g.tasks)
+	g.tasks = append(g.tasks, TaskResult{Name: name})
+	g.mu.Unlock()
+
+	if err := g.acquire(); err != nil {
+		g.finish(i, 0, err, true)
+		return
+	}
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		defer g.release()
+		start := g.clk.Now()
+		err := runTask(g.ctx, fn)
+		g.finish(i, g.clk.Since(start), err, false)
+	}()
+}
+
+// acquire mengambil slot semaphore, atau mengembalikan error context jika
+// group batal lebih dulu.
+func (g *Group) acquire() error {
+	if err := g.ctx.Err(); err != nil {
+		return err
+	}
+	if g.sem == nil {
+		return nil
+	}
+	select {
+	case g.sem <- struct{}{}:
+		// Slot bisa lepas bersamaan dengan pembatalan, misalnya dari task
+		// yang baru gagal; select memilih acak, jadi periksa lagi.
+		if err := g.ctx.Err(); err != nil {
+			g.release()
+			return err
+		}
+		return nil
+	case <-g.ctx.Done():
+		return g.ctx.Err()
+	}
+}
+
+func (g *Group) release() {
+	if g.sem != nil {
+		<-g.sem
+	}
+}
+
+// finish mencatat hasil task ke-i. Error pertama membatalkan group kecuali
+// CollectAll.
+func (g *Group) finish(i int, d time.Duration, err error, skipped bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.tasks[i].Err = err
+	g.tasks[i].Duration = d
+	g.tasks[i].Skipped = skipped
+	if err != nil && !skipped && g.first < 0 {
+		g.first = i
+		if !g.collectAll {
+			g.cancel()
+		}
+	}
+}
+
+// runTask memanggil fn dan mengubah panic menjadi *apperr.PanicError.
+func runTask(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = apperr.Recovered(r)
+		}
+	}()
+	return fn(ctx)
+}
+
+// Wait menunggu semua task selesai lalu membatalkan context group. Tanpa
+// CollectAll, Wait mengembalikan error task pertama yang gagal; dengan
+// CollectAll, gabungan error semua task yang gagal sesuai urutan Go. Error
+// diawali nama task dan tetap bisa diperiksa dengan errors.Is dan
+// errors.As. Jika tidak ada task yang gagal tetapi ada yang dilewati
+// karena ctx induk batal, error context yang dikembalikan.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	g.cancel()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.first >= 0 && !g.collectAll {
+		return taskError(g.tasks[g.first])
+	}
+	var errs []error
+	var skipped error
+	for _, t := range g.tasks {
+		switch {
+		case t.Skipped:
+			skipped = t.Err
+		case t.Err != nil:
+			errs = append(errs, taskError(t))
+		}
+	}
+	if len(errs) == 0 {
+		return skipped
+	}
+	return errors.Join(errs...)
+}
+
+func taskError(t TaskResult) error {
+	return fmt.Errorf("%s: %w", t.Name, t.Err)
+}
+
+// Results mengembalikan hasil semua task sesuai urutan Go. Panggil setelah
+// Wait agar semua hasil sudah lengkap.
+func (g *Group) Results() []TaskResult {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]TaskResult(nil), g.tasks...)
+}
@@ -0,0 +1,123 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"learn-go/apperr"
+)
+
+var errTask = errors.New("task gagal")
+
+// sleepTask mengembalikan task yang berjalan selama d lalu mengembalikan
+// err, atau berhenti lebih awal jika ctx batal.
+func sleepTask(d time.Duration, err error) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		select {
+		case <-time.After(d):
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func TestGroupLimitAndDurations(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		g := NewGroup(context.Background(), GroupConfig{Limit: 2})
+		var running, peak atomic.Int32
+		for _, name := range []string{"a", "b", "c", "d", "e"} {
+			g.Go(name, func(ctx context.Context) error {
+				n := running.Add(1)
+				defer running.Add(-1)
+				if n > peak.Load() {
+					peak.Store(n)
+				}
+				return sleepTask(time.Second, nil)(ctx)
+			})
+		}
+		if err := g.Wait(); err != nil {
+			t.Fatalf("Wait = %v", err)
+		}
+		if peak.Load() != 2 {
+			t.Errorf("paling banyak %d task bersamaan, want 2", peak.Load())
+		}
+		results := g.Results()
+		for i, r := range results {
+			if r.Name != string(rune('a'+i)) || r.Err != nil || r.Duration != time.Second {
+				t.Errorf("Results()[%d] = %+v", i, r)
+			}
+		}
+		if g.Context().Err() == nil {
+			t.Error("context group belum batal setelah Wait")
+		}
+	})
+}
+
+func TestGroupFirstErrorCancels(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		g := NewGroup(context.Background(), GroupConfig{Limit: 2})
+		g.Go("lambat", sleepTask(time.Hour, nil))
+		g.Go("gagal", sleepTask(time.Second, errTask))
+		g.Go("antre", sleepTask(time.Second, nil)) // menunggu slot sampai group batal
+
+		err := g.Wait()
+		if !errors.Is(err, errTask) || !strings.HasPrefix(err.Error(), "gagal: ") {
+			t.Errorf("Wait = %v, want gagal: %v", err, errTask)
+		}
+		results := g.Results()
+		if r := results[0]; !errors.Is(r.Err, context.Canceled) || r.Duration != time.Second {
+			t.Errorf("task lambat = %+v, want dibatalkan setelah 1s", r)
+		}
+		if r := results[2]; !r.Skipped || !errors.Is(r.Err, context.Canceled) {
+			t.Errorf("task antre = %+v, want dilewati", r)
+		}
+	})
+}
+
+func TestGroupCollectAll(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		g := NewGroup(context.Background(), GroupConfig{CollectAll: true})
+		errOther := errors.New("lain")
+		g.Go("a", sleepTask(time.Second, errTask))
+		g.Go("b", sleepTask(2*time.Second, nil))
+		g.Go("c", sleepTask(time.Millisecond, errOther))
+
+		err := g.Wait()
+		if !errors.Is(err, errTask) || !errors.Is(err, errOther) {
+			t.Errorf("Wait = %v, want kedua error", err)
+		}
+		if want := "a: task gagal\nc: lain"; err.Error() != want {
+			t.Errorf("Wait = %q, want %q (urut sesuai Go)", err, want)
+		}
+		if r := g.Results()[1]; r.Err != nil || r.Duration != 2*time.Second {
+			t.Errorf("task b = %+v, want selesai tanpa dibatalkan", r)
+		}
+	})
+}
+
+func TestGroupPanic(t *testing.T) {
+	g := NewGroup(context.Background(), GroupConfig{})
+	g.Go("panik", func(context.Context) error { panic("boom") })
+
+	var pe *apperr.PanicError
+	if err := g.Wait(); !errors.As(err, &pe) || pe.Value != "boom" || len(pe.Stack) == 0 {
+		t.Errorf("Wait = %v, want *PanicError boom", err)
+	}
+}
+
+func TestGroupParentCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	g := NewGroup(ctx, GroupConfig{})
+	ran := false
+	g.Go("a", func(context.Context) error { ran = true; return nil })
+	if err := g.Wait(); !errors.Is(err, context.Canceled) || ran {
+		t.Errorf("Wait = %v, dijalankan = %v; want context.Canceled tanpa menjalankan task", err, ran)
+	}
+}
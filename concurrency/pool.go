@@ -0,0 +1,231 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+)
+
+// ========== WORKER POOL GENERIK ==========
+
+// ErrPoolClosed dikembalikan Submit setelah pool ditutup.
+var ErrPoolClosed = errors.New("concurrency: pool sudah ditutup")
+
+// PoolConfig mengatur Pool. Nilai nol siap dipakai.
+type PoolConfig struct {
+	// Workers adalah jumlah goroutine worker; <= 0 berarti runtime.NumCPU().
+	Workers int
+	// Ordered membuat Results mengirim hasil sesuai urutan Submit, bukan
+	// urutan selesai.
+	Ordered bool
+	// Limiter dipakai bersama oleh semua worker: setiap job menunggu
+	// Limiter.Wait sebelum dijalankan. nil berarti tanpa batas laju.
+	Limiter Limiter
+}
+
+// Limiter membatasi laju job di Pool, misalnya limiter dari paket
+// ratelimit. Error dari Wait menjadi Err hasil job.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// Result adalah hasil satu job. Index adalah urutan job saat di-Submit,
+// dimulai dari 0.
+type Result[In, Out any] struct {
+	Index  int
+	Input  In
+	Output Out
+	Err    error
+}
+
+// Pool menjalankan fungsi yang sama untuk banyak input dengan sejumlah
+// worker tetap. Setiap job yang berhasil di-Submit menghasilkan tepat satu
+// Result, termasuk job yang batal karena context: Err-nya berisi ctx.Err().
+//
+// Results harus dibaca sampai tertutup; worker menunggu jika hasil tidak
+// dibaca.
+type Pool[In, Out any] struct {
+	ctx     context.Context
+	fn      func(ctx context.Context, in In) (Out, error)
+	limiter Limiter
+
+	mu        sync.Mutex // menjaga jobs, closed dan next saat Submit
+	jobs      chan job[In]
+	closed    bool
+	next      int
+	closing   chan struct{}
+	closeOnce sync.Once
+
+	raw     chan Result[In, Out] // hasil dari worker sebelum diurutkan
+	results chan Result[In, Out]
+}
+
+type job[In any] struct {
+	index int
+	in    In
+}
+
+type workerIDKey struct{}
+
+// WorkerID mengembalikan nomor worker (dimulai dari 1) yang menjalankan
+// job, dari context yang diterima fungsi job. Nilainya 0 di luar Pool.
+func WorkerID(ctx context.Context) int {
+	id, _ := ctx.Value(workerIDKey{}).(int)
+	return id
+}
+
+// NewPool menjalankan worker yang memanggil fn untuk setiap job. Membatalkan
+// ctx sama dengan Close, ditambah job yang belum mulai langsung selesai
+// dengan error ctx.Err() dan fn yang sedang berjalan menerima context yang
+// sudah batal.
+func NewPool[In, Out any](ctx context.Context, cfg PoolConfig, fn func(ctx context.Context, in In) (Out, error)) *Pool[In, Out] {
+	workers := cfg.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	p := &Pool[In, Out]{
+		ctx:     ctx,
+		fn:      fn,
+		limiter: cfg.Limiter,
+		jobs:    make(chan job[In], workers),
+		closing: make(chan struct{}),
+		raw:     make(chan Result[In, Out], workers),
+		results: make(chan Result[In, Out]),
+	}
+
+	var wg sync.WaitGroup
+	for id := 1; id <= workers; id++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.work(context.WithValue(ctx, workerIDKey{}, id))
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(p.raw)
+	}()
+	go p.collect(cfg.Ordered)
+
+	// Pembatalan context menutup pool agar worker tidak menunggu job
+	// selamanya.
+	go func() {
+		select {
+		case <-ctx.Done():
+			p.Close()
+		case <-p.closing:
+		}
+	}()
+	return p
+}
+
+// work menjalankan job sampai channel jobs ditutup dan kosong.
+func (p *Pool[In, Out]) work(ctx context.Context) {
+	for j := range p.jobs {
+		r := Result[In, Out]{Index: j.index, Input: j.in}
+		r.Err = ctx.Err()
+		if r.Err == nil && p.limiter != nil {
+			r.Err = p.limiter.Wait(ctx)
+		}
+		if r.Err == nil {
+			r.Output, r.Err = p.fn(ctx, j.in)
+		}
+		p.raw <- r
+	}
+}
+
+// collect meneruskan hasil worker ke Results. Jika ordered, hasil yang
+// datang lebih awal ditahan sampai semua hasil sebelumnya terkirim.
+func (p *Pool[In, Out]) collect(ordered bool) {
+	defer close(p.results)
+	if !ordered {
+		for r := range p.raw {
+			p.results <- r
+		}
+		return
+	}
+	pending := make(map[int]Result[In, Out])
+	next := 0
+	for r := range p.raw {
+		pending[r.Index] = r
+		for {
+			r, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			p.results <- r
+			next++
+		}
+	}
+}
+
+// Submit mengantrekan in. Submit menunggu jika antrean penuh, dan gagal
+// dengan ErrPoolClosed setelah Close atau ctx.Err() setelah context batal.
+func (p *Pool[In, Out]) Submit(in In) error {
+	if err := p.ctx.Err(); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return ErrPoolClosed
+	}
+	select {
+	case p.jobs <- job[In]{index: p.next, in: in}:
+		p.next++
+		return nil
+	case <-p.closing:
+		if err := p.ctx.Err(); err != nil {
+			return err
+		}
+		return ErrPoolClosed
+	}
+}
+
+// Results mengembalikan channel hasil. Channel ditutup setelah Close dan
+// semua job yang sudah diantrekan selesai.
+func (p *Pool[In, Out]) Results() <-chan Result[In, Out] {
+	return p.results
+}
+
+// Close berhenti menerima job baru. Job yang sudah diantrekan dan yang
+// sedang berjalan tetap diselesaikan. Close aman dipanggil berkali-kali.
+func (p *Pool[In, Out]) Close() {
+	p.closeOnce.Do(func() {
+		// closing membangunkan Submit yang sedang menunggu antrean agar
+		// kunci bisa diambil.
+		close(p.closing)
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.closed = true
+		close(p.jobs)
+	})
+}
+
+// Process menjalankan fn untuk setiap elemen inputs dengan pool sesuai cfg
+// dan mengembalikan hasil sesuai urutan inputs (cfg.Ordered selalu
+// dianggap true). Jika ctx batal, input yang belum sempat diproses mendapat
+// Err ctx.Err().
+func Process[In, Out any](ctx context.Context, cfg PoolConfig, inputs []In, fn func(ctx context.Context, in In) (Out, error)) []Result[In, Out] {
+	cfg.Ordered = true
+	p := NewPool(ctx, cfg, fn)
+	go func() {
+		defer p.Close()
+		for _, in := range inputs {
+			if p.Submit(in) != nil {
+				return
+			}
+		}
+	}()
+
+	results := make([]Result[In, Out], 0, len(inputs))
+	for r := range p.Results() {
+		results = append(results, r)
+	}
+	for i := len(results); i < len(inputs); i++ {
+		results = append(results, Result[In, Out]{Index: i, Input: inputs[i], Err: ctx.Err()})
+	}
+	return results
+}
@@ -0,0 +1,173 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"learn-go/clock"
+	"learn-go/ratelimit"
+)
+
+var errOdd = errors.New("ganjil")
+
+// slowDouble mengembalikan in*2 setelah jeda yang lebih lama untuk input
+// kecil, sehingga urutan selesai berbeda dari urutan input.
+func slowDouble(ctx context.Context, in int) (int, error) {
+	select {
+	case <-time.After(time.Duration(10-in%10) * time.Millisecond):
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+	if in%7 == 0 {
+		return 0, errOdd
+	}
+	return in * 2, nil
+}
+
+func TestProcessOrdered(t *testing.T) {
+	inputs := make([]int, 30)
+	for i := range inputs {
+		inputs[i] = i + 1
+	}
+	results := Process(context.Background(), PoolConfig{Workers: 4}, inputs, slowDouble)
+	if len(results) != len(inputs) {
+		t.Fatalf("len = %d, want %d", len(results), len(inputs))
+	}
+	for i, r := range results {
+		if r.Index != i || r.Input != inputs[i] {
+			t.Fatalf("results[%d] = %+v", i, r)
+		}
+		if r.Input%7 == 0 {
+			if !errors.Is(r.Err, errOdd) {
+				t.Errorf("results[%d].Err = %v, want errOdd", i, r.Err)
+			}
+		} else if r.Err != nil || r.Output != r.Input*2 {
+			t.Errorf("results[%d] = %+v", i, r)
+		}
+	}
+}
+
+func TestPoolWorkerCount(t *testing.T) {
+	var running, peak atomic.Int32
+	fn := func(ctx context.Context, in int) (int, error) {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+		return WorkerID(ctx), nil
+	}
+
+	p := NewPool(context.Background(), PoolConfig{Workers: 3}, fn)
+	go func() {
+		defer p.Close()
+		for i := range 20 {
+			p.Submit(i)
+		}
+	}()
+	n := 0
+	for r := range p.Results() {
+		n++
+		if r.Output < 1 || r.Output > 3 {
+			t.Errorf("WorkerID = %d, want 1-3", r.Output)
+		}
+	}
+	if n != 20 || peak.Load() > 3 {
+		t.Errorf("hasil %d, worker bersamaan paling banyak %d", n, peak.Load())
+	}
+	if err := p.Submit(1); !errors.Is(err, ErrPoolClosed) {
+		t.Errorf("Submit setelah Close = %v, want ErrPoolClosed", err)
+	}
+}
+
+// TestPoolCancel memastikan job yang batal tetap menghasilkan Result dan
+// Results ditutup tanpa Close.
+func TestPoolCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	fn := func(ctx context.Context, in int) (int, error) {
+		if in == 0 {
+			close(started)
+		}
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+	p := NewPool(ctx, PoolConfig{Workers: 1, Ordered: true}, fn)
+	for i := range 2 { // satu berjalan, satu menunggu di antrean
+		if err := p.Submit(i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	<-started
+	cancel()
+
+	var got []Result[int, int]
+	for r := range p.Results() {
+		got = append(got, r)
+	}
+	if len(got) != 2 || !errors.Is(got[0].Err, context.Canceled) || !errors.Is(got[1].Err, context.Canceled) {
+		t.Errorf("hasil setelah cancel = %+v", got)
+	}
+	if err := p.Submit(3); !errors.Is(err, context.Canceled) {
+		t.Errorf("Submit setelah cancel = %v", err)
+	}
+}
+
+// TestPoolCloseDrains memastikan Close menunggu job yang sudah diantrekan.
+func TestPoolCloseDrains(t *testing.T) {
+	p := NewPool(context.Background(), PoolConfig{Workers: 2}, slowDouble)
+	for i := range 4 {
+		if err := p.Submit(i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p.Close()
+	p.Close()
+
+	sum := 0
+	for r := range p.Results() {
+		sum += r.Output
+	}
+	if sum != (0+1+2+3)*2 {
+		t.Errorf("jumlah hasil = %d", sum)
+	}
+}
+
+// TestPoolLimiter memastikan semua worker berbagi satu limiter.
+func TestPoolLimiter(t *testing.T) {
+	clk := clock.NewFake(time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC))
+	limiter := ratelimit.NewTokenBucket(clk, time.Second, 2)
+	p := NewPool(context.Background(), PoolConfig{Workers: 4, Limiter: limiter}, func(_ context.Context, in int) (int, error) {
+		return in, nil
+	})
+	for i := range 4 {
+		if err := p.Submit(i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p.Close()
+
+	// Dua job memakai burst, dua lainnya menunggu token berikutnya.
+	<-p.Results()
+	<-p.Results()
+	clk.BlockUntil(2)
+	select {
+	case r := <-p.Results():
+		t.Fatalf("job %d lolos sebelum token terisi", r.Input)
+	default:
+	}
+	clk.Advance(time.Second)
+	<-p.Results()
+	clk.Advance(time.Second)
+	<-p.Results()
+	if _, ok := <-p.Results(); ok {
+		t.Error("Results belum ditutup")
+	}
+}
@@ -0,0 +1,45 @@
+package concurrency
+
+import (
+	"context"
+	"time"
+
+	"learn-go/clock"
+)
+
+// ========== SELECT DENGAN MULTIPLE CHANNELS ==========
+
+// FibonacciSelect mengirim deret Fibonacci ke c sampai ctx batal, lalu
+// mengembalikan ctx.Err(). Penerima menghentikannya dengan membatalkan ctx
+// setelah mendapat cukup nilai.
+func FibonacciSelect(ctx context.Context, c chan<- int) error {
+	x, y := 0, 1
+	for {
+		select {
+		case c <- x:
+			x, y = y, x+y
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// ReceiveWithTimeout menunggu pesan dari ch paling lama timeout menurut
+// clk. Jika waktu habis lebih dulu, error-nya context.DeadlineExceeded,
+// sama seperti ctx yang melewati deadline-nya; jika ctx batal, ctx.Err().
+// ErrChannelClosed dikembalikan jika ch ditutup.
+func ReceiveWithTimeout(ctx context.Context, clk clock.Clock, ch <-chan string, timeout time.Duration) (string, error) {
+	t := clk.NewTimer(timeout)
+	defer t.Stop()
+	select {
+	case msg, ok := <-ch:
+		if !ok {
+			return "", ErrChannelClosed
+		}
+		return msg, nil
+	case <-t.C():
+		return "", context.DeadlineExceeded
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
@@ -0,0 +1,97 @@
+// Package concurrency berisi building block goroutine dan channel: worker
+// pool generik, WaitGroup, counter yang thread-safe dan select. Pola
+// fan-in/fan-out ada di paket pipeline; Generator, Square dan FanIn hanya
+// dipertahankan untuk kode lama.
+//
+// Semua fungsi yang bisa menunggu menerima context.Context sebagai
+// parameter pertama, berhenti begitu context batal atau melewati
+// deadline, dan mengembalikan ctx.Err(), sehingga pemanggil selalu bisa
+// menghentikan goroutine yang menjalankannya.
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"learn-go/clock"
+)
+
+// ErrChannelClosed dikembalikan fungsi yang menerima dari channel jika
+// channel itu ditutup sebelum ada nilai.
+var ErrChannelClosed = errors.New("concurrency: channel sudah ditutup")
+
+// send mengirim v ke ch kecuali ctx batal lebih dulu.
+func send[T any](ctx context.Context, ch chan<- T, v T) error {
+	select {
+	case ch <- v:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// recv menerima satu nilai dari ch kecuali ctx batal lebih dulu.
+func recv[T any](ctx context.Context, ch <-chan T) (T, error) {
+	select {
+	case v, ok := <-ch:
+		if !ok {
+			return v, ErrChannelClosed
+		}
+		return v, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// sleep menunggu d menurut clk kecuali ctx batal lebih dulu.
+func sleep(ctx context.Context, clk clock.Clock, d time.Duration) error {
+	// Timer dihentikan agar tidak tertinggal jika ctx batal lebih dulu.
+	t := clk.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ========== GOROUTINE DAN CHANNEL FUNCTIONS ==========
+
+// Ping mengirim msg ke pings.
+func Ping(ctx context.Context, pings chan<- string, msg string) error {
+	return send(ctx, pings, msg)
+}
+
+// Pong meneruskan satu pesan dari pings ke pongs.
+func Pong(ctx context.Context, pings <-chan string, pongs chan<- string) error {
+	msg, err := recv(ctx, pings)
+	if err != nil {
+		return err
+	}
+	return send(ctx, pongs, msg)
+}
+
+// ========== FUNGSI DENGAN WAITGROUP ==========
+
+// TaskWithWaitGroup mensimulasikan task selama id*100ms menurut clk dan
+// menandai wg selesai ketika kembali. Jika report tidak nil, report
+// dipanggil saat task mulai (done false) dan selesai (done true); task
+// yang batal di tengah jalan tidak dilaporkan selesai.
+func TaskWithWaitGroup(ctx context.Context, clk clock.Clock, id int, wg *sync.WaitGroup, report func(id int, done bool)) error {
+	defer wg.Done() // Menandai task selesai
+
+	if report != nil {
+		report(id, false)
+	}
+	if err := sleep(ctx, clk, time.Millisecond*time.Duration(id*100)); err != nil {
+		return err
+	}
+	if report != nil {
+		report(id, true)
+	}
+	return nil
+}
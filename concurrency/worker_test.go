@@ -0,0 +1,213 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"learn-go/clock"
+)
+
+var start = time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
+
+func TestTaskWithWaitGroup(t *testing.T) {
+	clk := clock.NewFake(start)
+	finished := make(chan int)
+	report := func(id int, done bool) {
+		if done {
+			finished <- id
+		}
+	}
+
+	var wg sync.WaitGroup
+	for id := 1; id <= 3; id++ {
+		wg.Add(1)
+		go TaskWithWaitGroup(context.Background(), clk, id, &wg, report)
+	}
+	// Task ke-n selesai setelah n*100ms; majukan 100ms demi 100ms.
+	clk.BlockUntil(3)
+	for id := 1; id <= 3; id++ {
+		clk.Advance(100 * time.Millisecond)
+		if got := <-finished; got != id {
+			t.Errorf("setelah %dms task %d selesai, want task %d", id*100, got, id)
+		}
+	}
+	wg.Wait()
+}
+
+func TestIncrementCounter(t *testing.T) {
+	clk := clock.NewFake(start)
+	var counter SafeCounter
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Add(1)
+		go IncrementCounter(context.Background(), clk, &counter, 3, &wg)
+	}
+	for range 3 {
+		clk.BlockUntil(2)
+		clk.Advance(time.Millisecond)
+	}
+	wg.Wait()
+	if counter.Value() != 6 {
+		t.Errorf("Value = %d, want 6", counter.Value())
+	}
+}
+
+func TestReceiveWithTimeout(t *testing.T) {
+	clk := clock.NewFake(start)
+	ch := make(chan string, 1)
+
+	done := make(chan error)
+	go func() {
+		_, err := ReceiveWithTimeout(context.Background(), clk, ch, 2*time.Second)
+		done <- err
+	}()
+	clk.BlockUntil(1)
+	clk.Advance(2 * time.Second)
+	if err := <-done; !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("ReceiveWithTimeout error = %v, want DeadlineExceeded", err)
+	}
+
+	ch <- "halo"
+	if msg, err := ReceiveWithTimeout(context.Background(), clk, ch, 2*time.Second); err != nil || msg != "halo" {
+		t.Errorf("ReceiveWithTimeout = %q, %v", msg, err)
+	}
+	if clk.Waiters() != 0 {
+		t.Errorf("Waiters = %d, timer tidak dihentikan", clk.Waiters())
+	}
+}
+
+// ========== PEMBATALAN ==========
+
+// Test di bawah berjalan di dalam synctest: synctest.Test gagal jika ada
+// goroutine yang masih tertahan ketika fungsi test selesai, jadi lolosnya
+// test sekaligus membuktikan tidak ada goroutine yang bocor.
+
+// cancelWhenBlocked membatalkan ctx setelah semua goroutine di bubble
+// tertahan, yaitu ketika helper yang diuji sedang menunggu.
+func cancelWhenBlocked(cancel context.CancelFunc) {
+	synctest.Wait()
+	cancel()
+}
+
+func TestHelpersStopOnCancel(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(ctx context.Context) error
+	}{
+		{"Ping", func(ctx context.Context) error {
+			return Ping(ctx, make(chan string), "halo")
+		}},
+		{"Pong/terima", func(ctx context.Context) error {
+			return Pong(ctx, make(chan string), make(chan string))
+		}},
+		{"Pong/kirim", func(ctx context.Context) error {
+			pings := make(chan string, 1)
+			pings <- "halo"
+			return Pong(ctx, pings, make(chan string))
+		}},
+		{"TaskWithWaitGroup", func(ctx context.Context) error {
+			var wg sync.WaitGroup
+			wg.Add(1)
+			reported := false
+			err := TaskWithWaitGroup(ctx, clock.Real, 3, &wg, func(_ int, done bool) { reported = reported || done })
+			wg.Wait()
+			if reported {
+				t.Error("TaskWithWaitGroup melaporkan selesai setelah batal")
+			}
+			return err
+		}},
+		{"IncrementCounter", func(ctx context.Context) error {
+			var wg sync.WaitGroup
+			var counter SafeCounter
+			wg.Add(1)
+			err := IncrementCounter(ctx, clock.Real, &counter, 100, &wg)
+			wg.Wait()
+			if counter.Value() != 1 {
+				t.Errorf("Value = %d, want 1", counter.Value())
+			}
+			return err
+		}},
+		{"FibonacciSelect", func(ctx context.Context) error {
+			return FibonacciSelect(ctx, make(chan int))
+		}},
+		{"ReceiveWithTimeout", func(ctx context.Context) error {
+			_, err := ReceiveWithTimeout(ctx, clock.Real, make(chan string), time.Hour)
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			synctest.Test(t, func(t *testing.T) {
+				ctx, cancel := context.WithCancel(context.Background())
+				go cancelWhenBlocked(cancel)
+				if err := tt.run(ctx); !errors.Is(err, context.Canceled) {
+					t.Errorf("error = %v, want context.Canceled", err)
+				}
+			})
+		})
+	}
+}
+
+func TestDeadline(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		// Deadline ctx lebih awal dari timeout milik ReceiveWithTimeout.
+		begin := time.Now()
+		_, err := ReceiveWithTimeout(ctx, clock.Real, make(chan string), time.Hour)
+		if !errors.Is(err, context.DeadlineExceeded) || time.Since(begin) != time.Second {
+			t.Errorf("error = %v setelah %v, want DeadlineExceeded setelah 1s", err, time.Since(begin))
+		}
+
+		// Penerima lambat: satu nilai setiap 300ms, jadi dalam 1s hanya
+		// empat nilai yang sempat terkirim.
+		c := make(chan int)
+		got := make(chan []int)
+		go func() {
+			var seq []int
+			for n := range c {
+				seq = append(seq, n)
+				time.Sleep(300 * time.Millisecond)
+			}
+			got <- seq
+		}()
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if err := FibonacciSelect(ctx, c); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("FibonacciSelect error = %v, want DeadlineExceeded", err)
+		}
+		close(c)
+		if seq := <-got; !slices.Equal(seq, []int{0, 1, 1, 2}) {
+			t.Errorf("deret = %v, want [0 1 1 2]", seq)
+		}
+	})
+}
+
+func TestReceiveClosedChannel(t *testing.T) {
+	ch := make(chan string)
+	close(ch)
+	if _, err := ReceiveWithTimeout(context.Background(), clock.Real, ch, time.Hour); !errors.Is(err, ErrChannelClosed) {
+		t.Errorf("ReceiveWithTimeout error = %v, want ErrChannelClosed", err)
+	}
+	if err := Pong(context.Background(), ch, make(chan string, 1)); !errors.Is(err, ErrChannelClosed) {
+		t.Errorf("Pong error = %v, want ErrChannelClosed", err)
+	}
+}
+
+func TestFanInDeprecated(t *testing.T) {
+	in := Generator(1, 2, 3, 4)
+	var got []int
+	for n := range FanIn(Square(in), Square(in)) {
+		got = append(got, n)
+	}
+	slices.Sort(got)
+	if !slices.Equal(got, []int{1, 4, 9, 16}) {
+		t.Errorf("FanIn(Square, Square) = %v, want [1 4 9 16]", got)
+	}
+}
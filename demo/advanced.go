@@ -0,0 +1,63 @@
+package demo
+
+import "learn-go/functional"
+
+func init() {
+	Register(Demo{
+		ID:       "advanced",
+		Order:    2,
+		Title:    Title{ID: "Fungsi Lanjutan", EN: "Advanced Functions"},
+		Category: "basics",
+		Description: Title{
+			ID: "Function sebagai variable, higher-order function dan closure",
+			EN: "Functions as variables, higher-order functions and closures",
+		},
+		Sections: []Section{
+			{Title{ID: "Function sebagai Variable", EN: "Function as Variable"}, advancedFunctionVariable},
+			{Title{ID: "Closure", EN: "Closure"}, advancedClosure},
+			{Title{ID: "Closure dengan Parameter", EN: "Closure with Parameters"}, advancedClosureParam},
+			{Title{ID: "Function Validator", EN: "Function Validator"}, advancedValidator},
+			{Title{ID: "Menerapkan Function ke Slice", EN: "Apply Function to Slice"}, advancedApplyToSlice},
+		},
+	})
+}
+
+func advancedFunctionVariable(o *Output) {
+	multiply := func(a, b int) int { return a * b }
+	subtract := func(a, b int) int { return a - b }
+
+	result1 := functional.MathOperation(4, 5, multiply)
+	result2 := functional.MathOperation(10, 3, subtract)
+	o.Emit(Call("functional.MathOperation", args(4, 5, "multiply"), result1), "4 * 5 = %d\n", result1)
+	o.Emit(Call("functional.MathOperation", args(10, 3, "subtract"), result2), "10 - 3 = %d\n", result2)
+}
+
+func advancedClosure(o *Output) {
+	incrementer := functional.Counter()
+	for i := 0; i < 3; i++ {
+		n := incrementer()
+		o.Emit(Call("functional.Counter", nil, n), o.T("advanced.counter"), n)
+	}
+}
+
+func advancedClosureParam(o *Output) {
+	double := functional.Multiplier(2)
+	triple := functional.Multiplier(3)
+	o.Emit(Call("functional.Multiplier(2)", 5, double(5)), o.T("advanced.double"), 5, double(5))
+	o.Emit(Call("functional.Multiplier(3)", 4, triple(4)), o.T("advanced.triple"), 4, triple(4))
+}
+
+func advancedValidator(o *Output) {
+	isValidAge := functional.CreateValidator(0, 120)
+	isValidScore := functional.CreateValidator(0, 100)
+	o.Emit(Call("functional.CreateValidator(0, 120)", 25, isValidAge(25)), o.T("advanced.age_valid"), 25, isValidAge(25))
+	o.Emit(Call("functional.CreateValidator(0, 120)", 150, isValidAge(150)), o.T("advanced.age_valid"), 150, isValidAge(150))
+	o.Emit(Call("functional.CreateValidator(0, 100)", 85, isValidScore(85)), o.T("advanced.score_valid"), 85, isValidScore(85))
+}
+
+func advancedApplyToSlice(o *Output) {
+	numbers := []int{1, 2, 3, 4, 5}
+	squared := functional.ApplyToSlice(numbers, func(x int) int { return x * x })
+	o.Printf(o.T("common.original"), numbers)
+	o.Emit(Call("functional.ApplyToSlice", numbers, squared), o.T("advanced.squared"), squared)
+}
@@ -0,0 +1,72 @@
+// Package demo berisi contoh penggunaan setiap paket dalam modul ini. Setiap
+// file mendaftarkan satu kategori contoh ke registry dan menulis hasilnya
+// melalui Output sehingga bisa ditampilkan sebagai teks atau JSON, dalam
+// bahasa Indonesia maupun Inggris.
+package demo
+
+import "learn-go/mathx"
+
+// ========== FUNGSI DASAR ==========
+
+// Fungsi sederhana tanpa parameter dan return value
+func sayHello(o *Output) {
+	o.Log("sayHello", o.T("basic.hello_world"))
+}
+
+// Fungsi dengan parameter
+func greet(o *Output, name string) {
+	o.Logf("greet", o.T("basic.greet"), name)
+}
+
+func init() {
+	Register(Demo{
+		ID:       "basic",
+		Order:    1,
+		Title:    Title{ID: "Fungsi Dasar", EN: "Basic Functions"},
+		Category: "basics",
+		Description: Title{
+			ID: "Parameter, return value, multiple return, named return dan variadic",
+			EN: "Parameters, return values, multiple returns, named returns and variadics",
+		},
+		Sections: []Section{
+			{Title{ID: "Fungsi Sederhana", EN: "Simple Functions"}, basicSimple},
+			{Title{ID: "Fungsi dengan Return Value", EN: "Functions with Return Values"}, basicReturn},
+			{Title{ID: "Multiple Return Value", EN: "Multiple Return Values"}, basicMultipleReturn},
+			{Title{ID: "Named Return Value", EN: "Named Return Values"}, basicNamedReturn},
+			{Title{ID: "Parameter Variadic", EN: "Variadic Parameters"}, basicVariadic},
+		},
+	})
+}
+
+func basicSimple(o *Output) {
+	sayHello(o)
+	greet(o, "Alice")
+}
+
+func basicReturn(o *Output) {
+	result := mathx.Add(5, 3)
+	o.Emit(Call("mathx.Add", args(5, 3), result), "5 + 3 = %d\n", result)
+}
+
+func basicMultipleReturn(o *Output) {
+	for _, b := range []float64{2, 0} {
+		quotient, err := mathx.Divide(10, b)
+		r := Call("mathx.Divide", args(10.0, b), quotient).WithError(err)
+		if err != nil {
+			o.Emit(r, o.T("common.error"), o.Error(err))
+		} else {
+			o.Emit(r, "10 / %.0f = %.2f\n", b, quotient)
+		}
+	}
+}
+
+func basicNamedReturn(o *Output) {
+	s, p := mathx.Calculate(4, 5)
+	o.Emit(Call("mathx.Calculate", args(4, 5), map[string]int{"sum": s, "product": p}),
+		o.T("basic.sum_product"), s, p)
+}
+
+func basicVariadic(o *Output) {
+	total := mathx.Sum(1, 2, 3, 4, 5)
+	o.Emit(Call("mathx.Sum", args(1, 2, 3, 4, 5), total), o.T("basic.variadic_sum"), total)
+}
@@ -0,0 +1,449 @@
+package demo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"learn-go/apperr"
+	"learn-go/concurrency"
+	"learn-go/metrics"
+	"learn-go/pipeline"
+	"learn-go/pubsub"
+	"learn-go/ratelimit"
+	"learn-go/supervisor"
+)
+
+func init() {
+	Register(Demo{
+		ID:       "concurrency",
+		Order:    6,
+		Title:    Title{ID: "Goroutine dan Channel", EN: "Concurrency Functions"},
+		Category: "advanced",
+		Description: Title{
+			ID: "Worker pool, WaitGroup, mutex, select, timeout, fan-in/fan-out, rate limiter, supervisor, pub/sub, metrik dan task group",
+			EN: "Worker pools, WaitGroup, mutexes, select, timeouts, fan-in/fan-out, rate limiters, supervisors, pub/sub, metrics and task groups",
+		},
+		Sections: []Section{
+			{Title{ID: "Pola Worker Pool", EN: "Worker Pool Pattern"}, concurrencyWorkerPool},
+			{Title{ID: "Ping-Pong dengan Channel", EN: "Ping-Pong with Channels"}, concurrencyPingPong},
+			{Title{ID: "Contoh WaitGroup", EN: "WaitGroup Example"}, concurrencyWaitGroup},
+			{Title{ID: "Contoh Mutex", EN: "Mutex Example"}, concurrencyMutex},
+			{Title{ID: "Buffered Channel", EN: "Buffered Channel"}, concurrencyBufferedChannel},
+			{Title{ID: "Select dengan Timeout", EN: "Select with Timeout"}, concurrencyTimeout},
+			{Title{ID: "Pola Fan-in Fan-out", EN: "Fan-in Fan-out Pattern"}, concurrencyFanInFanOut},
+			{Title{ID: "Fibonacci dengan Select", EN: "Fibonacci with Select"}, concurrencyFibonacci},
+			{Title{ID: "Pembatas Laju", EN: "Rate Limiting"}, concurrencyRateLimit},
+			{Title{ID: "Supervisor Goroutine", EN: "Goroutine Supervisor"}, concurrencySupervisor},
+			{Title{ID: "Broker Pub/Sub", EN: "Pub/Sub Broker"}, concurrencyPubSub},
+			{Title{ID: "Metrik Tanpa Lock", EN: "Lock-free Metrics"}, concurrencyMetrics},
+			{Title{ID: "Task Group", EN: "Task Group"}, concurrencyTaskGroup},
+		},
+	})
+}
+
+var (
+	// errNegativeJob dikembalikan job worker pool untuk input negatif.
+	errNegativeJob = errors.New("job tidak boleh negatif")
+)
+
+func concurrencyWorkerPool(o *Output) {
+	double := func(ctx context.Context, job int) (int, error) {
+		o.Logf("concurrency.Process", o.T("concurrency.worker_processing"), concurrency.WorkerID(ctx), job)
+		select {
+		case <-o.Clock().After(100 * time.Millisecond): // Simulasi kerja
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+		if job < 0 {
+			return 0, errNegativeJob
+		}
+		return job * 2, nil
+	}
+
+	// 3 worker, hasil dikembalikan sesuai urutan input
+	jobs := []int{1, 2, 3, -4, 5}
+	for _, r := range concurrency.Process(context.Background(), concurrency.PoolConfig{Workers: 3}, jobs, double) {
+		if r.Err != nil {
+			o.Emit(Call("concurrency.Process", r.Input, nil).WithError(r.Err), o.T("concurrency.job_failed"), r.Input, o.Error(r.Err))
+			continue
+		}
+		o.Emit(Call("concurrency.Process", r.Input, r.Output), o.T("concurrency.result"), r.Input, r.Output)
+	}
+}
+
+func concurrencyPingPong(o *Output) {
+	ctx := context.Background()
+	pings := make(chan string, 1)
+	pongs := make(chan string, 1)
+
+	concurrency.Ping(ctx, pings, "Hello")
+	err := concurrency.Pong(ctx, pings, pongs)
+	msg := <-pongs
+	o.Emit(Call("concurrency.Pong", "Hello", msg).WithError(err), "%s\n", msg)
+}
+
+func concurrencyWaitGroup(o *Output) {
+	var wg sync.WaitGroup
+	report := func(id int, done bool) {
+		key := "concurrency.task_starting"
+		if done {
+			key = "concurrency.task_completed"
+		}
+		o.Logf("concurrency.TaskWithWaitGroup", o.T(key), id)
+	}
+
+	for i := 1; i <= 3; i++ {
+		wg.Add(1)
+		go concurrency.TaskWithWaitGroup(context.Background(), o.Clock(), i, &wg, report)
+	}
+
+	wg.Wait()
+	o.Print(o.T("concurrency.all_tasks_completed"))
+}
+
+func concurrencyMutex(o *Output) {
+	counter := &concurrency.SafeCounter{}
+	var wg sync.WaitGroup
+
+	// Start 5 goroutines yang masing-masing increment 10 kali
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go concurrency.IncrementCounter(context.Background(), o.Clock(), counter, 10, &wg)
+	}
+
+	wg.Wait()
+	o.Emit(Call("concurrency.SafeCounter.Value", "5 goroutine x 10", counter.Value()),
+		o.T("concurrency.final_counter"), counter.Value())
+}
+
+func concurrencyBufferedChannel(o *Output) {
+	// Buffered channel dengan kapasitas 3
+	ch := make(chan int, 3)
+
+	// Mengirim tanpa blocking karena ada buffer
+	ch <- 1
+	ch <- 2
+	ch <- 3
+
+	o.Printf(o.N("concurrency.sent_values", len(ch)), len(ch))
+
+	// Menerima nilai
+	for i := 0; i < 3; i++ {
+		v := <-ch
+		o.Emit(Call("<-ch", nil, v), o.T("concurrency.received_int"), v)
+	}
+}
+
+func concurrencyTimeout(o *Output) {
+	// cancel menghentikan goroutine pengirim yang masih menunggu setelah
+	// timeout, sehingga tidak ada goroutine yang tertinggal.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := make(chan string)
+
+	// Pesan dikirim setelah timeout sehingga select memilih case timeout
+	go func() {
+		select {
+		case <-o.Clock().After(3 * time.Second):
+			concurrency.Ping(ctx, ch, o.T("concurrency.hello_later"))
+		case <-ctx.Done():
+		}
+	}()
+
+	msg, err := concurrency.ReceiveWithTimeout(ctx, o.Clock(), ch, 2*time.Second)
+	r := Call("concurrency.ReceiveWithTimeout", "2s", msg).WithError(err)
+	if err == nil {
+		o.Emit(r, o.T("concurrency.received"), msg)
+	} else {
+		o.Emit(r, o.N("concurrency.timeout", 2), 2)
+	}
+}
+
+func concurrencyFanInFanOut(o *Output) {
+	square := func(ctx context.Context, n int) (int, error) {
+		select {
+		case <-o.Clock().After(100 * time.Millisecond): // Simulasi kerja
+			return n * n, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+
+	p := pipeline.New(context.Background())
+	input := pipeline.Source(p, 1, 2, 3, 4, 5)
+
+	// Fan-out: distribute work to multiple workers
+	var workers []<-chan int
+	for _, in := range pipeline.FanOut(p, input, 2) {
+		workers = append(workers, pipeline.Map(p, in, square))
+	}
+
+	// Fan-in: merge results
+	output := pipeline.Merge(p, workers...)
+
+	var squared []int
+	var b strings.Builder
+	err := pipeline.Sink(p, output, func(_ context.Context, n int) error {
+		squared = append(squared, n)
+		fmt.Fprintf(&b, "%d ", n)
+		return nil
+	})
+	o.Emit(Call("pipeline.Merge", []int{1, 2, 3, 4, 5}, squared).WithError(err), o.T("concurrency.squared_results"), b.String())
+}
+
+func concurrencyFibonacci(o *Output) {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := make(chan int)
+	var seq []int
+
+	// Penerima menghentikan generator dengan membatalkan ctx
+	go func() {
+		for i := 0; i < 10; i++ {
+			seq = append(seq, <-c)
+		}
+		cancel()
+	}()
+
+	concurrency.FibonacciSelect(ctx, c)
+
+	var b strings.Builder
+	for _, n := range seq {
+		fmt.Fprintf(&b, "%d ", n)
+	}
+	o.Emit(Call("concurrency.FibonacciSelect", 10, seq), o.T("concurrency.fibonacci_quit"), b.String())
+}
+
+func concurrencyRateLimit(o *Output) {
+	// Tiga limiter dengan batas 3 permintaan per detik
+	limiters := []struct {
+		name    string
+		limiter ratelimit.Limiter
+	}{
+		{"ratelimit.TokenBucket", ratelimit.NewTokenBucket(o.Clock(), time.Second/3, 3)},
+		{"ratelimit.LeakyBucket", ratelimit.NewLeakyBucket(o.Clock(), time.Second/3, 3)},
+		{"ratelimit.SlidingWindow", ratelimit.NewSlidingWindow(o.Clock(), time.Second, 3)},
+	}
+
+	// 5 permintaan sekaligus: token bucket dan sliding window meloloskan
+	// ledakan sampai 3, leaky bucket hanya satu karena jaraknya tetap
+	for _, l := range limiters {
+		allowed := 0
+		for range 5 {
+			if l.limiter.Allow() {
+				allowed++
+			}
+		}
+		o.Emit(Call(l.name+".Allow", 5, allowed), o.T("concurrency.rate_allowed"), l.name, allowed, 5)
+	}
+}
+
+func concurrencySupervisor(o *Output) {
+	onExit := func(child string, err error, restart bool) {
+		switch {
+		case restart:
+			o.Printf(o.T("concurrency.child_restart"), child, err)
+		case err != nil:
+			o.Printf(o.T("concurrency.child_failed"), child, err)
+		default:
+			o.Printf(o.T("concurrency.child_done"), child)
+		}
+	}
+	// crashing mengembalikan anak yang panic pada percobaan ke-1 sampai
+	// crashes, lalu selesai normal.
+	crashing := func(name string, crashes int) supervisor.Child {
+		attempt := 0
+		return supervisor.Child{Name: name, Restart: supervisor.Transient, Run: func(ctx context.Context) error {
+			attempt++
+			if attempt <= crashes {
+				panic(o.Sprintf("concurrency.child_panic", attempt))
+			}
+			return nil
+		}}
+	}
+
+	// importer pulih setelah 2 kali panic; watcher terus panic sehingga
+	// supervisor menyerah setelah 2 restart.
+	for _, child := range []supervisor.Child{crashing("importer", 2), crashing("watcher", 10)} {
+		s := supervisor.New(supervisor.Config{MaxRestarts: 2, OnExit: onExit, Clock: o.Clock()}, child)
+		err := s.Run(context.Background())
+		st := s.Status()[0]
+		r := Call("supervisor.Supervisor.Run", st.Name, st.State.String()).WithError(err)
+		if err != nil {
+			o.Emit(r, o.T("concurrency.supervisor_gave_up"), st.Name, o.Error(err))
+			continue
+		}
+		o.Emit(r, o.N("concurrency.supervisor_status", st.Restarts), st.Name, st.State, st.Restarts)
+	}
+}
+
+func concurrencyPubSub(o *Output) {
+	ctx := context.Background()
+	b := pubsub.New[string]()
+
+	// Setiap subscriber punya antrean sendiri. Antrean dashboard dan
+	// mailer sengaja kecil agar terlihat kebijakan saat antrean penuh.
+	subs := []struct {
+		name    string
+		pattern string
+		opts    pubsub.Options
+	}{
+		{"audit", "order.#", pubsub.Options{Buffer: 10}},
+		{"gudang", "order.created", pubsub.Options{Buffer: 10}},
+		{"dashboard", "*.created", pubsub.Options{Buffer: 2, Policy: pubsub.DropOldest}},
+		{"mailer", "order.*", pubsub.Options{Buffer: 2, Policy: pubsub.DropNewest}},
+	}
+	var subscriptions []*pubsub.Subscription[string]
+	for _, s := range subs {
+		sub, err := b.Subscribe(s.pattern, s.opts)
+		if err != nil {
+			o.Emit(Call("pubsub.Broker.Subscribe", s.pattern, nil).WithError(err), "%s\n", o.Error(err))
+			return
+		}
+		subscriptions = append(subscriptions, sub)
+	}
+
+	events := []pubsub.Message[string]{
+		{Topic: "order.created", Payload: "order-1"},
+		{Topic: "payment.created", Payload: "pay-1"},
+		{Topic: "order.item.added", Payload: "item-7"},
+		{Topic: "order.created", Payload: "order-2"},
+		{Topic: "order.created", Payload: "order-3"},
+	}
+	for _, e := range events {
+		n, err := b.Publish(ctx, e.Topic, e.Payload)
+		o.Emit(Call("pubsub.Broker.Publish", args(e.Topic, e.Payload), n).WithError(err),
+			o.N("concurrency.published", n), e.Topic, e.Payload, n)
+	}
+
+	// Close menutup channel setiap subscriber; pesan yang sudah di
+	// antrean tetap bisa dibaca sampai habis.
+	b.Close()
+	for i, sub := range subscriptions {
+		var got []string
+		for msg := range sub.C() {
+			got = append(got, msg.Payload)
+		}
+		r := Call("pubsub.Subscription.C", subs[i].name, got)
+		o.Emit(r, o.T("concurrency.subscriber_got"), subs[i].name, sub.Pattern(), subs[i].opts.Policy, got, sub.Dropped())
+	}
+
+	_, err := b.Publish(ctx, "order.created", "order-4")
+	o.Emit(Call("pubsub.Broker.Publish", args("order.created", "order-4"), 0).WithError(err),
+		o.T("concurrency.publish_closed"), o.Error(err))
+}
+
+func concurrencyMetrics(o *Output) {
+	const goroutines, times = 100, 1000
+
+	// Ketiga counter menghasilkan nilai yang sama. Bedanya ada di biaya:
+	// SafeCounter mengunci satu mutex untuk setiap Increment, Counter
+	// memakai satu nilai atomik, dan ShardedCounter membagi penambahan ke
+	// beberapa shard (lihat benchmark di paket metrics).
+	reg := metrics.NewRegistry()
+	safe := &concurrency.SafeCounter{}
+	counter := reg.Counter("jobs_total", o.T("concurrency.help_jobs"))
+	sharded := reg.ShardedCounter("events_total", o.T("concurrency.help_events"), 0)
+
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range times {
+				safe.Increment()
+				counter.Inc()
+				sharded.Inc()
+			}
+		}()
+	}
+	wg.Wait()
+	workload := fmt.Sprintf("%d goroutine x %d", goroutines, times)
+	for _, c := range []struct {
+		name  string
+		value uint64
+	}{
+		{"concurrency.SafeCounter", uint64(safe.Value())},
+		{"metrics.Counter", counter.Value()},
+		{"metrics.ShardedCounter", sharded.Value()},
+	} {
+		o.Emit(Call(c.name+".Value", workload, c.value), o.T("concurrency.counter_value"), c.name, c.value, workload)
+	}
+
+	// Histogram mencatat sebaran durasi job ke bucket tetap; gauge
+	// menyimpan nilai yang bisa naik turun.
+	queue := reg.Gauge("queue_depth", o.T("concurrency.help_queue"))
+	durations := reg.Histogram("job_duration_seconds", o.T("concurrency.help_duration"), []float64{0.01, 0.1, 1})
+	for _, d := range []float64{0.004, 0.02, 0.05, 0.3, 2.5} {
+		queue.Inc()
+		durations.Observe(d)
+	}
+	queue.Add(-2)
+
+	var b strings.Builder
+	reg.WritePrometheus(&b)
+	o.Emit(Call("metrics.Registry.WritePrometheus", nil, b.String()), o.T("concurrency.prometheus"), b.String())
+}
+
+func concurrencyTaskGroup(o *Output) {
+	// download mensimulasikan unduhan selama d; ukuran negatif gagal.
+	download := func(d time.Duration, size int) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			select {
+			case <-o.Clock().After(d):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			if size < 0 {
+				return errNegativeJob
+			}
+			return nil
+		}
+	}
+	files := []struct {
+		name string
+		d    time.Duration
+		size int
+	}{
+		{"a.csv", 300 * time.Millisecond, 10},
+		{"b.csv", 100 * time.Millisecond, -1},
+		{"c.csv", 500 * time.Millisecond, 20},
+		{"d.csv", 200 * time.Millisecond, 5},
+	}
+
+	// Tanpa CollectAll, kegagalan b.csv membatalkan a.csv yang sedang
+	// berjalan dan task yang belum mendapat slot dilewati. Dengan
+	// CollectAll, semua task tetap berjalan dan panic menjadi error biasa.
+	for _, collectAll := range []bool{false, true} {
+		cfg := concurrency.GroupConfig{Limit: 2, CollectAll: collectAll, Clock: o.Clock()}
+		o.Printf("GroupConfig{Limit: %d, CollectAll: %v}:\n", cfg.Limit, cfg.CollectAll)
+
+		g := concurrency.NewGroup(context.Background(), cfg)
+		for _, f := range files {
+			g.Go(f.name, download(f.d, f.size))
+		}
+		g.Go("rusak.csv", func(context.Context) error {
+			panic(o.T("concurrency.task_panic"))
+		})
+		err := g.Wait()
+
+		for _, r := range g.Results() {
+			d := r.Duration.Round(10 * time.Millisecond)
+			switch {
+			case r.Skipped:
+				o.Printf(o.T("concurrency.task_skipped"), r.Name, o.Error(r.Err))
+			case r.Err != nil:
+				o.Printf(o.T("concurrency.task_failed"), r.Name, d, o.Error(r.Err))
+			default:
+				o.Printf(o.T("concurrency.task_ok"), r.Name, d)
+			}
+		}
+
+		var pe *apperr.PanicError
+		o.Emit(Call("concurrency.Group.Wait", len(files)+1, nil).WithError(err),
+			o.T("concurrency.group_wait"), errors.Is(err, errNegativeJob), errors.As(err, &pe))
+	}
+}
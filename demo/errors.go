@@ -0,0 +1,442 @@
+package demo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"learn-go/apperr"
+	"learn-go/breaker"
+	"learn-go/cleanup"
+	"learn-go/clock"
+	"learn-go/fileproc"
+	"learn-go/mathx"
+	"learn-go/retry"
+)
+
+func init() {
+	Register(Demo{
+		ID:       "errors",
+		Order:    7,
+		Title:    Title{ID: "Penanganan Error", EN: "Error Handling"},
+		Category: "advanced",
+		Description: Title{
+			ID: "Defer, panic, recover, retry, circuit breaker dan manajemen resource",
+			EN: "Defer, panic, recover, retry, circuit breaker and resource management",
+		},
+		Sections: []Section{
+			{Title{ID: "Contoh Defer", EN: "Defer Examples"}, fileOperation},
+			{Title{ID: "Multiple Defer (urutan LIFO)", EN: "Multiple Defer (LIFO order)"}, multipleDefer},
+			{Title{ID: "Defer di dalam Loop", EN: "Defer in Loop"}, deferInLoop},
+			{Title{ID: "Pembagian Aman dengan Error", EN: "Safe Division with Errors"}, errorsSafeDivision},
+			{Title{ID: "Contoh Recover", EN: "Recover Demo"}, errorsRecoverDemo},
+			{Title{ID: "Recovery Bersarang", EN: "Nested Recovery"}, nestedRecovery},
+			{Title{ID: "Validasi Input dengan Error Terstruktur", EN: "Input Validation with Structured Errors"}, errorsValidation},
+			{Title{ID: "Operasi File dengan Defer", EN: "File Operations with Defer"}, errorsReadFile},
+			{Title{ID: "Operasi Kritis dengan Recovery", EN: "Critical Operations with Recovery"}, errorsCritical},
+			{Title{ID: "Manajemen Resource", EN: "Resource Management"}, errorsResourceManagement},
+			{Title{ID: "Retry dengan Backoff", EN: "Retry with Backoff"}, errorsRetry},
+			{Title{ID: "Circuit Breaker", EN: "Circuit Breaker"}, errorsBreaker},
+		},
+	})
+}
+
+// ========== DEFER, PANIC, RECOVER ==========
+
+// Fungsi dengan defer
+func fileOperation(o *Output) {
+	o.Log("fileOperation", o.T("errors.opening_file"))
+	defer o.Log("fileOperation", o.T("errors.closing_file")) // akan dieksekusi terakhir
+	defer o.Log("fileOperation", o.T("errors.cleaning_up"))  // LIFO order
+	o.Log("fileOperation", o.T("errors.processing_file"))
+}
+
+// Fungsi dengan multiple defer
+func multipleDefer(o *Output) {
+	o.Log("multipleDefer", o.T("errors.function_start"))
+	defer o.Logf("multipleDefer", "Defer %d\n", 1) // Terakhir dieksekusi
+	defer o.Logf("multipleDefer", "Defer %d\n", 2) // Kedua dieksekusi
+	defer o.Logf("multipleDefer", "Defer %d\n", 3) // Pertama dieksekusi
+	o.Log("multipleDefer", o.T("errors.function_end"))
+}
+
+// Fungsi dengan defer dalam loop
+func deferInLoop(o *Output) {
+	o.Log("deferInLoop", o.T("errors.defer_loop_example"))
+	for i := 1; i <= 3; i++ {
+		defer o.Logf("deferInLoop", o.T("errors.deferred"), i)
+	}
+	o.Log("deferInLoop", o.T("errors.loop_completed"))
+}
+
+// ========== PANIC DAN RECOVER ==========
+
+// criticalFailed adalah hasil criticalOperation setelah pulih dari panic.
+const criticalFailed = "FAILED"
+
+// safeDivision membagi a dengan b dan mengembalikan error alih-alih panic.
+// Pembagi nol dicek lebih dulu; recover tetap dipasang sebagai jaring
+// pengaman yang mengubah panic tak terduga menjadi error internal lengkap
+// dengan stack trace.
+func safeDivision(a, b int) (result int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = apperr.Newf(apperr.CodeInternal, "%v", r).WithStack()
+		}
+	}()
+
+	if b == 0 {
+		return 0, mathx.ErrDivisionByZero
+	}
+	return a / b, nil
+}
+
+// Fungsi yang mendemonstrasikan recover
+func recoverDemo(o *Output) {
+	defer func() {
+		if r := recover(); r != nil {
+			o.Logf("recoverDemo", o.T("errors.recovered_in"), "recoverDemo", r)
+		}
+	}()
+
+	o.Log("recoverDemo", o.T("errors.about_to_panic"))
+	panic(o.T("errors.something_wrong"))
+	// Baris setelah panic tidak akan pernah dieksekusi
+}
+
+// Fungsi dengan nested recovery
+func nestedRecovery(o *Output) {
+	defer func() {
+		if r := recover(); r != nil {
+			o.Logf("nestedRecovery", o.T("errors.outer_recovery"), r)
+		}
+	}()
+
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				o.Logf("nestedRecovery", o.T("errors.inner_recovery"), r)
+				panic(o.T("errors.re_panic"))
+			}
+		}()
+		panic(o.T("errors.inner_panic"))
+	}()
+}
+
+// validateInput memeriksa umur dan mengembalikan error validasi dengan
+// detail per field alih-alih panic.
+func validateInput(o *Output, age int) error {
+	switch {
+	case age < 0:
+		return apperr.New(apperr.CodeValidation, o.T("errors.invalid_input")).
+			WithField("age", o.T("errors.age_negative"))
+	case age > 150:
+		return apperr.New(apperr.CodeValidation, o.T("errors.invalid_input")).
+			WithField("age", o.Sprintf("errors.age_too_old", 150))
+	}
+	return nil
+}
+
+// ========== REAL-WORLD EXAMPLES ==========
+
+// Fungsi untuk membuka file dengan proper error handling. File dibaca
+// baris demi baris oleh fileproc.Scan, yang juga mengenali gzip dan BOM.
+// Error saat menutup file digabung ke error yang dikembalikan, bukan hanya
+// dicetak.
+func readFileWithDefer(o *Output, path string) (err error) {
+	name := filepath.Base(path)
+	o.Printf(o.T("errors.attempt_open"), name)
+
+	var stack cleanup.Stack
+	defer stack.CloseInto(&err) // file selalu ditutup, juga saat panic
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	stack.Push(name, func() error {
+		if err := file.Close(); err != nil {
+			return err
+		}
+		o.Logf("os.File.Close", o.T("errors.closed_ok"), name)
+		return nil
+	})
+
+	o.Logf("readFileWithDefer", o.T("errors.processing_named"), name)
+	c, err := fileproc.Scan(name, file, fileproc.Options{}, func(l fileproc.Line) error {
+		o.Printf(o.T("errors.file_line"), l.Number, l.Text)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if c.Compressed {
+		o.Print(o.T("errors.file_gzip"))
+	}
+	r := Call("fileproc.Scan", name, map[string]int64{"lines": int64(c.Lines), "words": int64(c.Words), "bytes": c.Bytes})
+	o.Emit(r, o.T("errors.file_stats"), c.Lines, c.Words, c.HumanBytes(), c.Encoding)
+	// Baris yang bukan UTF-8 valid tetap dihitung; posisinya dilaporkan
+	// sebagai peringatan, bukan error.
+	for _, w := range c.Warnings {
+		o.Emit(Call("fileproc.Scan", name, nil).WithError(w), o.T("errors.file_warning"), o.Error(w))
+	}
+	return nil
+}
+
+// Fungsi dengan logging dan recovery
+func criticalOperation(o *Output, id int) (result string) {
+	defer func() {
+		if r := recover(); r != nil {
+			o.Logf("criticalOperation", o.T("errors.critical_failed"), id, r)
+			result = criticalFailed
+		}
+	}()
+
+	o.Logf("criticalOperation", o.T("errors.critical_starting"), id)
+
+	// Simulasi operasi yang bisa panic
+	if id%2 == 0 {
+		panic(o.Sprintf("errors.critical_panic", id))
+	}
+
+	result = o.Sprintf("errors.critical_ok", id)
+	return
+}
+
+// flakyOperation meniru layanan yang tidak stabil: operasi panic pada
+// percobaan sebelum succeedAt, lalu berhasil.
+func flakyOperation(o *Output, id, succeedAt int) func(ctx context.Context) error {
+	attempt := 0
+	return func(ctx context.Context) error {
+		attempt++
+		if attempt < succeedAt {
+			panic(o.Sprintf("errors.critical_panic", id))
+		}
+		return nil
+	}
+}
+
+// allocate mendaftarkan resource db, file dan socket ke stack. Jika
+// socketBusy, closer socket gagal agar terlihat bagaimana error saat
+// melepas resource dikumpulkan.
+func allocate(o *Output, stack *cleanup.Stack, socketBusy bool) {
+	o.Log("resourceManagement", o.T("errors.allocating"))
+	for _, key := range []string{"errors.resource_db", "errors.resource_file", "errors.resource_socket"} {
+		name := o.T(key)
+		stack.Push(name, func() error {
+			o.Logf("resourceManagement", o.T("errors.releasing"), name)
+			if socketBusy && key == "errors.resource_socket" {
+				return errors.New(o.T("errors.socket_busy"))
+			}
+			return nil
+		})
+	}
+}
+
+// Fungsi dengan cleanup resources
+func resourceManagement(o *Output) (err error) {
+	var stack cleanup.Stack
+	defer stack.CloseInto(&err) // dilepas LIFO: socket, file, lalu db
+
+	allocate(o, &stack, true)
+	o.Log("resourceManagement", o.T("errors.using"))
+
+	// Simulasi error yang mungkin terjadi
+	if true { // Ganti dengan kondisi error
+		o.Log("resourceManagement", o.T("errors.error_occurred"))
+		return nil // closer di stack akan tetap dijalankan
+	}
+
+	o.Log("resourceManagement", o.T("errors.processing_done"))
+	return nil
+}
+
+// openResources mengalokasikan resource lalu menyerahkannya ke pemanggil
+// dengan Release, sehingga CloseInto di sini tidak melepasnya.
+func openResources(o *Output) (owned *cleanup.Stack, err error) {
+	var stack cleanup.Stack
+	defer stack.CloseInto(&err) // hanya melepas jika gagal sebelum Release
+
+	allocate(o, &stack, false)
+	return stack.Release(), nil
+}
+
+func errorsResourceManagement(o *Output) {
+	// Error dari semua closer digabung dengan errors.Join
+	err := resourceManagement(o)
+	o.Emit(Call("cleanup.Stack.Close", nil, nil).WithError(err), o.T("errors.release_failed"), o.Error(err))
+
+	owned, err := openResources(o)
+	if err != nil {
+		return
+	}
+	o.Emit(Call("cleanup.Stack.Release", nil, owned.Len()), o.T("errors.ownership"), owned.Len())
+	err = owned.Close()
+	o.Emit(Call("cleanup.Stack.Close", nil, nil).WithError(err), "%s", o.T("errors.released"))
+}
+
+func errorsSafeDivision(o *Output) {
+	for _, b := range []int{2, 0} {
+		result, err := safeDivision(10, b)
+		r := Call("safeDivision", args(10, b), result).WithError(err)
+		if err != nil {
+			o.Emit(r, o.T("errors.division_failed"), 10, b, apperr.CodeOf(err), o.Error(err))
+			continue
+		}
+		o.Emit(r, "10 / %d = %d\n", b, result)
+	}
+}
+
+func errorsRecoverDemo(o *Output) {
+	recoverDemo(o)
+	o.Print(o.T("errors.continues"))
+}
+
+func errorsValidation(o *Output) {
+	var last error
+	for _, age := range []int{25, -5, 200} {
+		if err := validateInput(o, age); err != nil {
+			o.Emit(Call("validateInput", age, nil).WithError(err), o.T("errors.validation_failed"), err)
+			last = err
+			continue
+		}
+		o.Emit(Call("validateInput", age, age), o.T("errors.valid_age"), age)
+	}
+
+	// Error terstruktur bisa langsung dikirim sebagai JSON, misalnya di
+	// respons API.
+	b, _ := json.Marshal(last)
+	o.Printf(o.T("errors.as_json"), b)
+}
+
+func errorsReadFile(o *Output) {
+	// File yang tidak ada: error dari os.Open.
+	if err := readFileWithDefer(o, "example.txt"); err != nil {
+		o.Emit(Call("readFileWithDefer", "example.txt", nil).WithError(err), o.T("errors.open_failed"), o.Error(err))
+	}
+
+	// File contoh dibuat di direktori sementara: satu file gzip dengan BOM
+	// UTF-8, dan satu file dengan byte yang bukan UTF-8 di baris 2.
+	dir, err := os.MkdirTemp("", "learn-go-*")
+	if err != nil {
+		o.Emit(Call("os.MkdirTemp", "", nil).WithError(err), o.T("errors.open_failed"), err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	zw.Write([]byte("\uFEFF" + o.T("errors.sample_text")))
+	zw.Close()
+	files := []struct {
+		name string
+		data []byte
+	}{
+		{"laporan.txt.gz", gz.Bytes()},
+		{"rusak.txt", []byte("baris satu\nbaris \xff dua\n")},
+	}
+	for _, f := range files {
+		o.Print("\n")
+		path := filepath.Join(dir, f.name)
+		if err := os.WriteFile(path, f.data, 0o644); err != nil {
+			o.Emit(Call("os.WriteFile", f.name, nil).WithError(err), o.T("errors.open_failed"), err)
+			continue
+		}
+		if err := readFileWithDefer(o, path); err != nil {
+			o.Emit(Call("readFileWithDefer", f.name, nil).WithError(err), o.T("errors.process_failed"), o.Error(err))
+		}
+	}
+}
+
+func errorsCritical(o *Output) {
+	for i := 1; i <= 4; i++ {
+		result := criticalOperation(o, i)
+		r := Call("criticalOperation", i, result)
+		if result == criticalFailed {
+			r = r.WithError(errors.New("recovered from panic"))
+		}
+		o.Emit(r, o.T("errors.result"), result)
+	}
+}
+
+func errorsRetry(o *Output) {
+	// Jeda kecil agar demo cepat; di program nyata Base biasanya ratusan
+	// milidetik.
+	policy := retry.Policy{
+		MaxAttempts: 3,
+		Backoff:     retry.Exponential{Base: time.Millisecond, Jitter: true},
+		Clock:       o.Clock(),
+	}
+	errInvalid := errors.New(o.T("errors.retry_invalid"))
+	operations := []func(ctx context.Context) error{
+		flakyOperation(o, 1, 1),
+		flakyOperation(o, 2, 3),
+		flakyOperation(o, 3, 5),
+		func(ctx context.Context) error { return retry.Permanent(errInvalid) },
+	}
+
+	for i, op := range operations {
+		id := i + 1
+		history, err := retry.Do(context.Background(), policy, op)
+		for _, a := range history {
+			if a.Err != nil {
+				o.Printf(o.T("errors.retry_attempt"), id, a.Number, a.Err)
+			}
+		}
+		r := Call("retry.Do", id, len(history)).WithError(err)
+		switch {
+		case err == nil:
+			o.Emit(r, o.N("errors.retry_ok", len(history)), id, len(history))
+		case errors.Is(err, retry.ErrExhausted):
+			o.Emit(r, o.N("errors.retry_exhausted", len(history)), id, len(history))
+		default:
+			o.Emit(r, o.T("errors.retry_permanent"), id, err)
+		}
+	}
+}
+
+func errorsBreaker(o *Output) {
+	// Jam palsu agar cool-down bisa dilewati tanpa menunggu.
+	clk := clock.NewFake(time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC))
+	b := breaker.New(breaker.Config{
+		FailureThreshold: 3,
+		CoolDown:         5 * time.Second,
+		Clock:            clk,
+		OnStateChange: func(from, to breaker.State) {
+			o.Printf(o.T("errors.breaker_state"), from, to)
+		},
+	})
+
+	healthy := false
+	service := func(ctx context.Context) error {
+		if !healthy {
+			return errors.New(o.T("errors.breaker_down"))
+		}
+		return nil
+	}
+	callService := func(n int) {
+		err := b.Do(context.Background(), service)
+		r := Call("breaker.Breaker.Do", n, b.State().String()).WithError(err)
+		if err != nil {
+			o.Emit(r, o.T("errors.breaker_failed"), n, o.Error(err))
+			return
+		}
+		o.Emit(r, o.T("errors.breaker_ok"), n)
+	}
+
+	for n := 1; n <= 4; n++ {
+		callService(n)
+	}
+	clk.Advance(5 * time.Second)
+	o.Printf(o.N("errors.breaker_wait", 5), 5)
+	healthy = true
+	callService(5)
+
+	m := b.Metrics()
+	o.Printf(o.T("errors.breaker_metrics"), m.Requests, m.Successes, m.Failures, m.Rejected, m.Trips)
+}
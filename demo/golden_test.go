@@ -0,0 +1,159 @@
+package demo
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"learn-go/clock"
+	"learn-go/i18n"
+)
+
+var update = flag.Bool("update", false, "tulis ulang file golden di testdata/")
+
+// Waktu dan seed tetap agar keluaran demo utility deterministik.
+var (
+	goldenNow  = time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
+	goldenSeed = uint64(42)
+)
+
+// goldenClock memakai paket time untuk jeda, timer dan timeout, tetapi
+// Now-nya dimulai dari goldenNow. Golden test menjalankan demo di dalam
+// synctest, sehingga jeda itu selesai seketika begitu semua goroutine demo
+// sedang menunggu, urutannya tetap sama seperti dengan jam sungguhan, dan
+// waktu yang berlalu (Since) selalu sama di setiap run.
+type goldenClock struct {
+	clock.Clock
+	start time.Time // waktu synctest saat clock dibuat
+}
+
+func newGoldenClock() goldenClock {
+	return goldenClock{Clock: clock.Real, start: time.Now()}
+}
+
+func (c goldenClock) Now() time.Time { return goldenNow.Add(time.Since(c.start)) }
+
+func (c goldenClock) Since(t time.Time) time.Duration { return c.Now().Sub(t) }
+
+// normalizers menyeragamkan keluaran section yang urutannya bergantung pada
+// penjadwalan goroutine. Key berformat "<demo>/<nomor section>".
+var normalizers = map[string]func(string) string{
+	"concurrency/1": func(s string) string {
+		// Worker mana yang mengambil job tidak bisa ditebak
+		return sortLines(regexp.MustCompile(`Worker \d+`).ReplaceAllString(s, "Worker N"))
+	},
+	"concurrency/3": sortLines,
+	"concurrency/7": sortNumbersInLines,
+}
+
+func TestGolden(t *testing.T) {
+	for _, l := range i18n.Locales() {
+		for _, d := range All() {
+			t.Run(string(l)+"/"+d.ID, func(t *testing.T) {
+				got := renderGolden(t, d, l)
+				path := filepath.Join("testdata", d.ID+"."+string(l)+".golden")
+
+				if *update {
+					if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+						t.Fatal(err)
+					}
+					return
+				}
+
+				want, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatalf("baca golden: %v (jalankan `go test ./demo -update`)", err)
+				}
+				if got != string(want) {
+					t.Errorf("keluaran %s berbeda dari %s\n--- got ---\n%s\n--- want ---\n%s", d.ID, path, got, want)
+				}
+			})
+		}
+	}
+}
+
+// renderGolden menjalankan d section per section dalam locale l dengan clock
+// dan seed tetap, lalu menerapkan normalizer untuk section yang tidak
+// deterministik. Kerangkanya sama dengan Demo.Run. Demo berjalan di dalam
+// synctest sehingga Sleep dan timeout selesai seketika, dan test gagal jika
+// ada goroutine demo yang masih tertahan setelah semua section selesai.
+func renderGolden(t *testing.T, d Demo, l i18n.Locale) (out string) {
+	t.Helper()
+	synctest.Test(t, func(t *testing.T) {
+		out = renderSections(t, d, l)
+	})
+	return out
+}
+
+func renderSections(t *testing.T, d Demo, l i18n.Locale) string {
+	var buf bytes.Buffer
+	o := NewOutput(&buf, FormatText)
+	o.SetLocale(l)
+	o.SetClock(newGoldenClock())
+	o.SetSeed(goldenSeed)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "=== %s ===\n", d.Heading(l))
+	for i := range d.Sections {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		buf.Reset()
+		if err := d.RunSection(o, i+1); err != nil {
+			t.Fatal(err)
+		}
+		text := buf.String()
+		if normalize, ok := normalizers[d.ID+"/"+strconv.Itoa(i+1)]; ok {
+			text = normalize(text)
+		}
+		b.WriteString(text)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// sortLines mengurutkan baris s dengan mempertahankan baris judul section.
+func sortLines(s string) string {
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	sort.Strings(lines[1:])
+	return strings.Join(lines, "\n") + "\n"
+}
+
+var numberListRe = regexp.MustCompile(`(\d+ )+`)
+
+// sortNumbersInLines mengurutkan deret angka yang dipisah spasi, misalnya
+// "9 1 4 " menjadi "1 4 9 ".
+func sortNumbersInLines(s string) string {
+	return numberListRe.ReplaceAllStringFunc(s, func(list string) string {
+		fields := strings.Fields(list)
+		nums := make([]int, len(fields))
+		for i, f := range fields {
+			nums[i], _ = strconv.Atoi(f)
+		}
+		sort.Ints(nums)
+		var b strings.Builder
+		for _, n := range nums {
+			fmt.Fprintf(&b, "%d ", n)
+		}
+		return b.String()
+	})
+}
+
+func TestGoldenDeterministic(t *testing.T) {
+	d, ok := Lookup("utility")
+	if !ok {
+		t.Fatal("demo utility tidak terdaftar")
+	}
+	if a, b := renderGolden(t, d, i18n.Default), renderGolden(t, d, i18n.Default); a != b {
+		t.Errorf("dua kali render dengan clock dan seed yang sama menghasilkan keluaran berbeda:\n%s\n---\n%s", a, b)
+	}
+}
@@ -0,0 +1,430 @@
+package demo
+
+import (
+	"context"
+
+	"learn-go/breaker"
+	"learn-go/fileproc"
+	"learn-go/i18n"
+	"learn-go/mathx"
+	"learn-go/pubsub"
+	"learn-go/supervisor"
+)
+
+// errorKeys memetakan error yang dikenal ke key katalog agar bisa
+// ditampilkan dalam locale Output. Slice, bukan map, agar urutan
+// pencocokan tetap: jika pesan sentinel tidak muncul di err.Error(),
+// terjemahan sentinel pertama yang cocok yang dipakai. Yang lebih spesifik
+// ditaruh lebih dulu; context.Canceled paling akhir karena sering ikut
+// terbungkus bersama penyebab yang lebih informatif.
+var errorKeys = []struct {
+	err error
+	key string
+}{
+	{mathx.ErrDivisionByZero, "error.division_by_zero"},
+	{errNegativeJob, "error.negative_job"},
+	{breaker.ErrOpen, "error.breaker_open"},
+	{supervisor.ErrTooManyRestarts, "error.too_many_restarts"},
+	{pubsub.ErrClosed, "error.broker_closed"},
+	{fileproc.ErrInvalidUTF8, "error.invalid_utf8"},
+	{fileproc.ErrLineTooLong, "error.line_too_long"},
+	{context.Canceled, "error.canceled"},
+}
+
+func init() {
+	i18n.Register(i18n.Indonesian, map[string]string{
+		"demo.category.basics":   "Dasar",
+		"demo.category.data":     "Data",
+		"demo.category.advanced": "Lanjutan",
+		"demo.category.practice": "Praktik",
+		"demo.error.no_section":  "demo %s tidak memiliki section %d (1-%d)",
+		"demo.run_all.start":     "MENJALANKAN SEMUA CONTOH",
+		"demo.run_all.done":      "SEMUA CONTOH SELESAI",
+
+		"error.division_by_zero":  "tidak bisa dibagi dengan nol",
+		"error.negative_job":      "job tidak boleh negatif",
+		"error.breaker_open":      "sirkuit terbuka, panggilan ditolak",
+		"error.too_many_restarts": "terlalu banyak restart",
+		"error.broker_closed":     "broker sudah ditutup",
+		"error.canceled":          "dibatalkan",
+		"error.invalid_utf8":      "bukan UTF-8 yang valid",
+		"error.line_too_long":     "baris terlalu panjang",
+
+		"common.array":    "Array: %v\n",
+		"common.error":    "Error: %s\n",
+		"common.original": "Asli: %v\n",
+		"common.sum":      "Jumlah: %d\n",
+
+		"basic.hello_world":  "Halo, Dunia!\n",
+		"basic.greet":        "Halo, %s!\n",
+		"basic.sum_product":  "Jumlah: %d, Hasil kali: %d\n",
+		"basic.variadic_sum": "Jumlah 1,2,3,4,5 = %d\n",
+
+		"advanced.counter":     "Counter: %d\n",
+		"advanced.double":      "Dua kali %d: %d\n",
+		"advanced.triple":      "Tiga kali %d: %d\n",
+		"advanced.age_valid":   "Umur %d valid? %t\n",
+		"advanced.score_valid": "Nilai %d valid? %t\n",
+		"advanced.squared":     "Dikuadratkan: %v\n",
+
+		"recursive.factorial":        "Faktorial dari %d: %d\n",
+		"recursive.fibonacci":        "Deret Fibonacci (%d pertama): %s\n",
+		"recursive.reversed":         "Dibalik: %s\n",
+		"recursive.search_found":     "Mencari %d: Ditemukan di indeks %d\n",
+		"recursive.search_not_found": "Mencari %d: Tidak ditemukan\n",
+
+		"structs.person_info":       "Nama: %s, Umur: %d, Email: %s",
+		"structs.is_adult":          "Sudah dewasa? %t\n",
+		"structs.after_update":      "Setelah diubah: %s\n",
+		"structs.shape_info":        "%s - Luas: %.2f, Keliling: %.2f\n",
+		"structs.total_area":        "Total luas semua bangun: %.2f\n",
+		"structs.employee_info":     "%s bekerja sebagai %s, tinggal di %s, %s, bergaji $%.2f\n",
+		"structs.employee_name":     "Nama karyawan: %s\n",
+		"structs.employee_city":     "Kota karyawan: %s\n",
+		"structs.violations":        "Data karyawan punya %d kesalahan:\n",
+		"structs.set_age_rejected":  "SetAge(%d) ditolak, umur tetap %d:\n",
+		"structs.rule.required":     "wajib diisi",
+		"structs.rule.age_range":    "umur harus antara 0 dan 150",
+		"structs.rule.email":        "format email tidak valid",
+		"structs.rule.zip_code":     "kode pos harus 5 digit dan tidak diawali 0",
+		"structs.rule.non_negative": "tidak boleh negatif",
+		"shape.Rectangle":           "Persegi panjang",
+		"shape.Circle":              "Lingkaran",
+		"shape.Triangle":            "Segitiga",
+
+		"slicemap.original":     "Slice asli: %v\n",
+		"slicemap.max":          "Maksimum: %d\n",
+		"slicemap.min":          "Minimum: %d\n",
+		"slicemap.average":      "Rata-rata: %.2f\n",
+		"slicemap.even":         "Bilangan genap: %v\n",
+		"slicemap.greater_than": "Bilangan > %d: %v\n",
+		"slicemap.unique":       "Bilangan unik: %v\n",
+		"slicemap.contains":     "Mengandung %d? %t\n",
+		"slicemap.range":        "Rentang %d-%d: %v\n",
+		"slicemap.word_count":   "Jumlah kata: %v\n",
+		"slicemap.keys":         "Key: %v\n",
+		"slicemap.values":       "Value: %v\n",
+		"slicemap.has_key":      "Punya key '%s'? %t\n",
+		"slicemap.merged":       "Gabungan: %v\n",
+		"slicemap.original_map": "Map asli: %v\n",
+		"slicemap.reversed_map": "Map dibalik: %v\n",
+
+		"concurrency.worker_processing":   "Worker %d memproses job %d\n",
+		"concurrency.result":              "Hasil job %d: %d\n",
+		"concurrency.job_failed":          "Job %d gagal: %s\n",
+		"concurrency.task_starting":       "Task %d dimulai\n",
+		"concurrency.task_completed":      "Task %d selesai\n",
+		"concurrency.all_tasks_completed": "Semua task selesai\n",
+		"concurrency.final_counter":       "Nilai akhir counter: %d\n",
+		"concurrency.sent_values":         "Mengirim %d nilai ke buffered channel\n",
+		"concurrency.received_int":        "Diterima: %d\n",
+		"concurrency.received":            "Diterima: %s\n",
+		"concurrency.hello_later":         "Halo setelah 3 detik",
+		"concurrency.timeout":             "Timeout: Tidak ada pesan dalam %d detik\n",
+		"concurrency.squared_results":     "Hasil kuadrat: %s\n",
+		"concurrency.fibonacci_quit":      "%sGenerator fibonacci berhenti\n",
+		"concurrency.rate_allowed":        "%s: %d dari %d permintaan diizinkan\n",
+		"concurrency.child_panic":         "koneksi putus pada percobaan %d",
+		"concurrency.child_restart":       "Anak %s berhenti: %v, dinyalakan ulang\n",
+		"concurrency.child_failed":        "Anak %s berhenti: %v\n",
+		"concurrency.child_done":          "Anak %s selesai\n",
+		"concurrency.supervisor_status":   "Status %s: %v setelah %d restart\n",
+		"concurrency.supervisor_gave_up":  "Supervisor %s menyerah: %s\n",
+		"concurrency.published":           "Publish %s %q: diterima %d subscriber\n",
+		"concurrency.subscriber_got":      "%-9s %-14s %-12s menerima %v, dibuang %d\n",
+		"concurrency.publish_closed":      "Publish setelah Close: %s\n",
+		"concurrency.counter_value":       "%-26s %d (%s)\n",
+		"concurrency.prometheus":          "Format teks Prometheus (GET /metrics pada learn-go serve):\n%s",
+		"concurrency.help_jobs":           "Jumlah job yang selesai.",
+		"concurrency.help_events":         "Jumlah event yang diproses.",
+		"concurrency.help_queue":          "Panjang antrean job.",
+		"concurrency.help_duration":       "Lama job dalam detik.",
+		"concurrency.task_panic":          "berkas rusak",
+		"concurrency.task_ok":             "  %-9s selesai dalam %v\n",
+		"concurrency.task_failed":         "  %-9s gagal setelah %v: %s\n",
+		"concurrency.task_skipped":        "  %-9s dilewati: %s\n",
+		"concurrency.group_wait":          "Wait: errors.Is(err, errNegativeJob) = %v, errors.As(err, &panicErr) = %v\n",
+
+		"errors.opening_file":       "Membuka file\n",
+		"errors.closing_file":       "Menutup file\n",
+		"errors.cleaning_up":        "Membersihkan resource\n",
+		"errors.processing_file":    "Memproses file\n",
+		"errors.function_start":     "Fungsi dimulai\n",
+		"errors.function_end":       "Fungsi berakhir\n",
+		"errors.defer_loop_example": "Contoh defer di dalam loop:\n",
+		"errors.deferred":           "Ditunda: %d\n",
+		"errors.loop_completed":     "Loop selesai\n",
+		"errors.recovered_in":       "Pulih di %s: %v\n",
+		"errors.about_to_panic":     "Akan panic\n",
+		"errors.something_wrong":    "Terjadi kesalahan!",
+		"errors.continues":          "Program tetap berjalan setelah recovery\n",
+		"errors.outer_recovery":     "Recovery luar: %v\n",
+		"errors.inner_recovery":     "Recovery dalam: %v\n",
+		"errors.re_panic":           "Panic ulang dari fungsi dalam",
+		"errors.inner_panic":        "Panic dalam",
+		"errors.validation_failed":  "Validasi gagal: %v\n",
+		"errors.invalid_input":      "input tidak valid",
+		"errors.as_json":            "Sebagai JSON: %s\n",
+		"errors.division_failed":    "%d / %d gagal [%s]: %s\n",
+		"errors.age_negative":       "umur tidak boleh negatif",
+		"errors.age_too_old":        "umur tidak boleh lebih dari %d",
+		"errors.valid_age":          "Umur valid: %d\n",
+		"errors.attempt_open":       "Mencoba membuka file: %s\n",
+		"errors.open_failed":        "Gagal membuka file: %v\n",
+		"errors.closed_ok":          "File %s berhasil ditutup\n",
+		"errors.processing_named":   "Memproses file: %s\n",
+		"errors.file_line":          "  %d| %s\n",
+		"errors.file_gzip":          "File terkompresi gzip, didekompresi otomatis\n",
+		"errors.file_stats":         "Hasil: %d baris, %d kata, %s, encoding %s\n",
+		"errors.process_failed":     "Gagal memproses file: %v\n",
+		"errors.file_warning":       "Peringatan: %s\n",
+		"errors.sample_text":        "Laporan harian\nSemua layanan berjalan normal\nTidak ada layanan yang gagal\n",
+		"errors.critical_failed":    "Operasi kritis %d gagal: %v\n",
+		"errors.critical_starting":  "Memulai operasi kritis %d\n",
+		"errors.critical_panic":     "operasi %d gagal secara tak terduga",
+		"errors.critical_ok":        "Operasi %d berhasil diselesaikan",
+		"errors.result":             "Hasil: %s\n",
+		"errors.retry_attempt":      "Operasi %d, percobaan %d gagal: %v\n",
+		"errors.retry_ok":           "Operasi %d berhasil setelah %d percobaan\n",
+		"errors.retry_exhausted":    "Operasi %d menyerah setelah %d percobaan\n",
+		"errors.retry_permanent":    "Operasi %d gagal permanen, tidak dicoba lagi: %v\n",
+		"errors.retry_invalid":      "data tidak valid",
+		"errors.breaker_state":      "Breaker: %v -> %v\n",
+		"errors.breaker_down":       "layanan tidak merespons",
+		"errors.breaker_ok":         "Panggilan %d berhasil\n",
+		"errors.breaker_failed":     "Panggilan %d gagal: %s\n",
+		"errors.breaker_wait":       "(%d detik kemudian)\n",
+		"errors.breaker_metrics":    "Diteruskan %d, berhasil %d, gagal %d, ditolak %d, terbuka %d kali\n",
+		"errors.allocating":         "Mengalokasikan resource...\n",
+		"errors.release_failed":     "Error saat melepas resource: %s\n",
+		"errors.socket_busy":        "socket masih dipakai",
+		"errors.released":           "Semua resource dilepas oleh pemanggil\n",
+		"errors.ownership":          "Kepemilikan %d resource dipindahkan ke pemanggil\n",
+		"errors.releasing":          "Melepas: %s\n",
+		"errors.using":              "Menggunakan resource...\n",
+		"errors.error_occurred":     "Terjadi error saat pemrosesan\n",
+		"errors.processing_done":    "Pemrosesan berhasil diselesaikan\n",
+		"errors.resource_db":        "Koneksi Database",
+		"errors.resource_file":      "File Handle",
+		"errors.resource_socket":    "Network Socket",
+
+		"utility.email_valid":       "Email '%s' valid? %t\n",
+		"utility.email_valid_regex": "Email '%s' valid (regex)? %t\n",
+		"utility.original_quoted":   "Asli: '%s'\n",
+		"utility.cleaned":           "Dibersihkan: '%s'\n",
+		"utility.title_case":        "Title case: %s\n",
+		"utility.palindrome":        "'%s' palindrom? %t\n",
+		"utility.circle_area":       "Luas lingkaran (r=%.1f): %.2f\n",
+		"utility.sphere_volume":     "Volume bola (r=%.1f): %.2f\n",
+		"utility.distance":          "Jarak dari (%d,%d) ke (%d,%d): %.2f\n",
+		"utility.is_prime":          "%d bilangan prima? %t\n",
+		"utility.primes_up_to":      "Bilangan prima sampai %d: %v\n",
+		"utility.formatted_number":  "Angka terformat: %s\n",
+		"utility.rounded":           "Dibulatkan ke %d angka desimal: %.2f\n",
+		"utility.current_time":      "Waktu sekarang (%s): %s\n",
+		"utility.age":               "Umur orang yang lahir %s: %d tahun\n",
+		"utility.leap_year":         "Tahun %d tahun kabisat? %t\n",
+		"utility.phone_valid":       "Nomor telepon '%s' valid? %t\n",
+		"utility.nik_valid":         "NIK '%s' valid? %t\n",
+		"utility.bytes":             "%d byte = %s\n",
+		"utility.random_string":     "String acak (panjang %d): %s\n",
+	})
+
+	i18n.Register(i18n.English, map[string]string{
+		"demo.category.basics":   "Basics",
+		"demo.category.data":     "Data",
+		"demo.category.advanced": "Advanced",
+		"demo.category.practice": "Practice",
+		"demo.error.no_section":  "demo %s has no section %d (1-%d)",
+		"demo.run_all.start":     "RUNNING ALL EXAMPLES",
+		"demo.run_all.done":      "ALL EXAMPLES FINISHED",
+
+		"error.division_by_zero":  "cannot divide by zero",
+		"error.negative_job":      "job must not be negative",
+		"error.breaker_open":      "circuit open, call rejected",
+		"error.too_many_restarts": "too many restarts",
+		"error.broker_closed":     "broker is closed",
+		"error.canceled":          "canceled",
+		"error.invalid_utf8":      "not valid UTF-8",
+		"error.line_too_long":     "line too long",
+
+		"common.array":    "Array: %v\n",
+		"common.error":    "Error: %s\n",
+		"common.original": "Original: %v\n",
+		"common.sum":      "Sum: %d\n",
+
+		"basic.hello_world":  "Hello, World!\n",
+		"basic.greet":        "Hello, %s!\n",
+		"basic.sum_product":  "Sum: %d, Product: %d\n",
+		"basic.variadic_sum": "Sum of 1,2,3,4,5 = %d\n",
+
+		"advanced.counter":     "Counter: %d\n",
+		"advanced.double":      "Double %d: %d\n",
+		"advanced.triple":      "Triple %d: %d\n",
+		"advanced.age_valid":   "Age %d valid? %t\n",
+		"advanced.score_valid": "Score %d valid? %t\n",
+		"advanced.squared":     "Squared: %v\n",
+
+		"recursive.factorial":        "Factorial of %d: %d\n",
+		"recursive.fibonacci":        "Fibonacci sequence (first %d): %s\n",
+		"recursive.reversed":         "Reversed: %s\n",
+		"recursive.search_found":     "Searching for %d: Found at index %d\n",
+		"recursive.search_not_found": "Searching for %d: Not found\n",
+
+		"structs.person_info":       "Name: %s, Age: %d, Email: %s",
+		"structs.is_adult":          "Is adult? %t\n",
+		"structs.after_update":      "After update: %s\n",
+		"structs.shape_info":        "%s - Area: %.2f, Perimeter: %.2f\n",
+		"structs.total_area":        "Total area of all shapes: %.2f\n",
+		"structs.employee_info":     "%s works as %s, lives at %s, %s, earns $%.2f\n",
+		"structs.employee_name":     "Employee name: %s\n",
+		"structs.employee_city":     "Employee city: %s\n",
+		"structs.violations":        "Employee data has %d error:\n|Employee data has %d errors:\n",
+		"structs.set_age_rejected":  "SetAge(%d) rejected, age stays %d:\n",
+		"structs.rule.required":     "is required",
+		"structs.rule.age_range":    "age must be between 0 and 150",
+		"structs.rule.email":        "invalid email format",
+		"structs.rule.zip_code":     "zip code must be 5 digits not starting with 0",
+		"structs.rule.non_negative": "must not be negative",
+		"shape.Rectangle":           "Rectangle",
+		"shape.Circle":              "Circle",
+		"shape.Triangle":            "Triangle",
+
+		"slicemap.original":     "Original slice: %v\n",
+		"slicemap.max":          "Max: %d\n",
+		"slicemap.min":          "Min: %d\n",
+		"slicemap.average":      "Average: %.2f\n",
+		"slicemap.even":         "Even numbers: %v\n",
+		"slicemap.greater_than": "Numbers > %d: %v\n",
+		"slicemap.unique":       "Unique numbers: %v\n",
+		"slicemap.contains":     "Contains %d? %t\n",
+		"slicemap.range":        "Range %d-%d: %v\n",
+		"slicemap.word_count":   "Word count: %v\n",
+		"slicemap.keys":         "Keys: %v\n",
+		"slicemap.values":       "Values: %v\n",
+		"slicemap.has_key":      "Has key '%s'? %t\n",
+		"slicemap.merged":       "Merged: %v\n",
+		"slicemap.original_map": "Original map: %v\n",
+		"slicemap.reversed_map": "Reversed map: %v\n",
+
+		"concurrency.worker_processing":   "Worker %d processing job %d\n",
+		"concurrency.result":              "Job %d result: %d\n",
+		"concurrency.job_failed":          "Job %d failed: %s\n",
+		"concurrency.task_starting":       "Task %d starting\n",
+		"concurrency.task_completed":      "Task %d completed\n",
+		"concurrency.all_tasks_completed": "All tasks completed\n",
+		"concurrency.final_counter":       "Final counter value: %d\n",
+		"concurrency.sent_values":         "Sent %d value to buffered channel\n|Sent %d values to buffered channel\n",
+		"concurrency.received_int":        "Received: %d\n",
+		"concurrency.received":            "Received: %s\n",
+		"concurrency.hello_later":         "Hello after 3 seconds",
+		"concurrency.timeout":             "Timeout: No message received within %d second\n|Timeout: No message received within %d seconds\n",
+		"concurrency.squared_results":     "Squared results: %s\n",
+		"concurrency.fibonacci_quit":      "%sFibonacci generator quit\n",
+		"concurrency.rate_allowed":        "%s: %d of %d requests allowed\n",
+		"concurrency.child_panic":         "connection lost on attempt %d",
+		"concurrency.child_restart":       "Child %s exited: %v, restarting\n",
+		"concurrency.child_failed":        "Child %s exited: %v\n",
+		"concurrency.child_done":          "Child %s finished\n",
+		"concurrency.supervisor_status":   "Status of %s: %v after %d restart\n|Status of %s: %v after %d restarts\n",
+		"concurrency.supervisor_gave_up":  "Supervisor for %s gave up: %s\n",
+		"concurrency.published":           "Publish %s %q: received by %d subscriber\n|Publish %s %q: received by %d subscribers\n",
+		"concurrency.subscriber_got":      "%-9s %-14s %-12s received %v, dropped %d\n",
+		"concurrency.publish_closed":      "Publish after Close: %s\n",
+		"concurrency.counter_value":       "%-26s %d (%s)\n",
+		"concurrency.prometheus":          "Prometheus text format (GET /metrics on learn-go serve):\n%s",
+		"concurrency.help_jobs":           "Number of completed jobs.",
+		"concurrency.help_events":         "Number of processed events.",
+		"concurrency.help_queue":          "Length of the job queue.",
+		"concurrency.help_duration":       "Job duration in seconds.",
+		"concurrency.task_panic":          "corrupt file",
+		"concurrency.task_ok":             "  %-9s finished in %v\n",
+		"concurrency.task_failed":         "  %-9s failed after %v: %s\n",
+		"concurrency.task_skipped":        "  %-9s skipped: %s\n",
+		"concurrency.group_wait":          "Wait: errors.Is(err, errNegativeJob) = %v, errors.As(err, &panicErr) = %v\n",
+
+		"errors.opening_file":       "Opening file\n",
+		"errors.closing_file":       "Closing file\n",
+		"errors.cleaning_up":        "Cleaning up resources\n",
+		"errors.processing_file":    "Processing file\n",
+		"errors.function_start":     "Function start\n",
+		"errors.function_end":       "Function end\n",
+		"errors.defer_loop_example": "Defer in loop example:\n",
+		"errors.deferred":           "Deferred: %d\n",
+		"errors.loop_completed":     "Loop completed\n",
+		"errors.recovered_in":       "Recovered in %s: %v\n",
+		"errors.about_to_panic":     "About to panic\n",
+		"errors.something_wrong":    "Something went wrong!",
+		"errors.continues":          "Program continues after recovery\n",
+		"errors.outer_recovery":     "Outer recovery: %v\n",
+		"errors.inner_recovery":     "Inner recovery: %v\n",
+		"errors.re_panic":           "Re-panic from inner function",
+		"errors.inner_panic":        "Inner panic",
+		"errors.validation_failed":  "Validation failed: %v\n",
+		"errors.invalid_input":      "invalid input",
+		"errors.as_json":            "As JSON: %s\n",
+		"errors.division_failed":    "%d / %d failed [%s]: %s\n",
+		"errors.age_negative":       "age cannot be negative",
+		"errors.age_too_old":        "age cannot be more than %d",
+		"errors.valid_age":          "Valid age: %d\n",
+		"errors.attempt_open":       "Attempting to open file: %s\n",
+		"errors.open_failed":        "Error opening file: %v\n",
+		"errors.closed_ok":          "File %s closed successfully\n",
+		"errors.processing_named":   "Processing file: %s\n",
+		"errors.file_line":          "  %d| %s\n",
+		"errors.file_gzip":          "File is gzip-compressed, decompressed transparently\n",
+		"errors.file_stats":         "Result: %d lines, %d words, %s, encoding %s\n",
+		"errors.process_failed":     "Error processing file: %v\n",
+		"errors.file_warning":       "Warning: %s\n",
+		"errors.sample_text":        "Daily report\nAll services running normally\nNo service has failed\n",
+		"errors.critical_failed":    "Critical operation %d failed: %v\n",
+		"errors.critical_starting":  "Starting critical operation %d\n",
+		"errors.critical_panic":     "operation %d failed unexpectedly",
+		"errors.critical_ok":        "Operation %d completed successfully",
+		"errors.result":             "Result: %s\n",
+		"errors.retry_attempt":      "Operation %d, attempt %d failed: %v\n",
+		"errors.retry_ok":           "Operation %d succeeded after %d attempt\n|Operation %d succeeded after %d attempts\n",
+		"errors.retry_exhausted":    "Operation %d gave up after %d attempt\n|Operation %d gave up after %d attempts\n",
+		"errors.retry_permanent":    "Operation %d failed permanently, not retried: %v\n",
+		"errors.retry_invalid":      "invalid data",
+		"errors.breaker_state":      "Breaker: %v -> %v\n",
+		"errors.breaker_down":       "service not responding",
+		"errors.breaker_ok":         "Call %d succeeded\n",
+		"errors.breaker_failed":     "Call %d failed: %s\n",
+		"errors.breaker_wait":       "(%d second later)\n|(%d seconds later)\n",
+		"errors.breaker_metrics":    "Passed %d, succeeded %d, failed %d, rejected %d, trips %d\n",
+		"errors.allocating":         "Allocating resources...\n",
+		"errors.release_failed":     "Error while releasing resources: %s\n",
+		"errors.socket_busy":        "socket still in use",
+		"errors.released":           "All resources released by the caller\n",
+		"errors.ownership":          "Ownership of %d resources moved to the caller\n",
+		"errors.releasing":          "Releasing: %s\n",
+		"errors.using":              "Using resources...\n",
+		"errors.error_occurred":     "An error occurred during processing\n",
+		"errors.processing_done":    "Processing completed successfully\n",
+		"errors.resource_db":        "Database Connection",
+		"errors.resource_file":      "File Handle",
+		"errors.resource_socket":    "Network Socket",
+
+		"utility.email_valid":       "Email '%s' valid? %t\n",
+		"utility.email_valid_regex": "Email '%s' valid (regex)? %t\n",
+		"utility.original_quoted":   "Original: '%s'\n",
+		"utility.cleaned":           "Cleaned: '%s'\n",
+		"utility.title_case":        "Title case: %s\n",
+		"utility.palindrome":        "'%s' is palindrome? %t\n",
+		"utility.circle_area":       "Circle area (r=%.1f): %.2f\n",
+		"utility.sphere_volume":     "Sphere volume (r=%.1f): %.2f\n",
+		"utility.distance":          "Distance from (%d,%d) to (%d,%d): %.2f\n",
+		"utility.is_prime":          "%d is prime? %t\n",
+		"utility.primes_up_to":      "Primes up to %d: %v\n",
+		"utility.formatted_number":  "Formatted number: %s\n",
+		"utility.rounded":           "Rounded to %d place: %.2f\n|Rounded to %d places: %.2f\n",
+		"utility.current_time":      "Current time (%s): %s\n",
+		"utility.age":               "Age for someone born on %s: %d year\n|Age for someone born on %s: %d years\n",
+		"utility.leap_year":         "Year %d is leap year? %t\n",
+		"utility.phone_valid":       "Phone '%s' valid? %t\n",
+		"utility.nik_valid":         "NIK '%s' valid? %t\n",
+		"utility.bytes":             "%d byte = %s\n|%d bytes = %s\n",
+		"utility.random_string":     "Random string (length %d): %s\n",
+	})
+}
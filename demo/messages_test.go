@@ -0,0 +1,41 @@
+package demo
+
+import (
+	"slices"
+	"testing"
+
+	"learn-go/i18n"
+)
+
+// TestCatalogComplete memastikan setiap key katalog tersedia di semua locale.
+func TestCatalogComplete(t *testing.T) {
+	want := i18n.Keys(i18n.Default)
+	for _, l := range i18n.Locales() {
+		got := i18n.Keys(l)
+		for _, key := range want {
+			if !slices.Contains(got, key) {
+				t.Errorf("locale %s tidak memiliki key %q", l, key)
+			}
+		}
+		for _, key := range got {
+			if !slices.Contains(want, key) {
+				t.Errorf("key %q hanya ada di locale %s", key, l)
+			}
+		}
+	}
+}
+
+// TestDemoTitles memastikan setiap demo dan section punya judul dalam dua
+// bahasa.
+func TestDemoTitles(t *testing.T) {
+	for _, d := range All() {
+		if d.Title.ID == "" || d.Title.EN == "" || d.Description.ID == "" || d.Description.EN == "" {
+			t.Errorf("demo %s: judul atau deskripsi belum lengkap", d.ID)
+		}
+		for i, s := range d.Sections {
+			if s.Title.ID == "" || s.Title.EN == "" {
+				t.Errorf("demo %s section %d: judul belum lengkap", d.ID, i+1)
+			}
+		}
+	}
+}
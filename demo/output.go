@@ -0,0 +1,269 @@
+package demo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"learn-go/clock"
+	"learn-go/i18n"
+)
+
+// Format menentukan bentuk keluaran demo.
+type Format string
+
+const (
+	FormatText  Format = "text"  // teks bebas seperti menu interaktif
+	FormatJSON  Format = "json"  // satu array JSON berisi semua record
+	FormatJSONL Format = "jsonl" // satu record JSON per baris (JSON Lines)
+)
+
+// ParseFormat mengubah nama format dari flag CLI menjadi Format.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case FormatText, FormatJSON, FormatJSONL:
+		return f, nil
+	default:
+		return "", fmt.Errorf("format tidak dikenal %q (pilih text, json atau jsonl)", s)
+	}
+}
+
+// Record adalah satu hasil pemanggilan fungsi di dalam demo.
+type Record struct {
+	Demo     string `json:"demo"`
+	Section  string `json:"section,omitempty"`
+	Function string `json:"function"`
+	Input    any    `json:"input,omitempty"`
+	Output   any    `json:"output,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Call membuat Record untuk pemanggilan function dengan input dan output.
+func Call(function string, input, output any) Record {
+	return Record{Function: function, Input: input, Output: output}
+}
+
+// WithError mengisi Error dari err. Record tidak berubah jika err nil.
+func (r Record) WithError(err error) Record {
+	if err != nil {
+		r.Error = err.Error()
+	}
+	return r
+}
+
+// args mengelompokkan beberapa argumen menjadi satu nilai Input.
+func args(v ...any) []any {
+	return v
+}
+
+// Output menulis keluaran demo sebagai teks atau record JSON. Output juga
+// menyediakan sumber waktu dan angka acak untuk demo sehingga keluarannya
+// bisa dibuat deterministik. Output aman dipakai dari banyak goroutine.
+type Output struct {
+	mu      sync.Mutex
+	w       io.Writer
+	format  Format
+	demo    string
+	section string
+	records []Record
+	observe func(Record)
+	clock   clock.Clock
+	rand    *rand.Rand
+	msg     *i18n.Printer
+	err     error // error tulis pertama, dikembalikan oleh Flush
+}
+
+// NewOutput membuat Output yang menulis ke w dengan format f, memakai jam
+// sistem, seed acak dan locale i18n.Default.
+func NewOutput(w io.Writer, f Format) *Output {
+	return &Output{
+		w:      w,
+		format: f,
+		clock:  clock.Real,
+		rand:   rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+		msg:    i18n.NewPrinter(i18n.Default),
+	}
+}
+
+// SetLocale mengganti bahasa keluaran teks demo.
+func (o *Output) SetLocale(l i18n.Locale) {
+	o.msg = i18n.NewPrinter(l)
+}
+
+// Locale mengembalikan bahasa keluaran teks demo.
+func (o *Output) Locale() i18n.Locale {
+	return o.msg.Locale()
+}
+
+// T mengembalikan format pesan key dalam locale Output.
+func (o *Output) T(key string) string {
+	return o.msg.T(key)
+}
+
+// Sprintf memformat pesan key dalam locale Output dengan args.
+func (o *Output) Sprintf(key string, args ...any) string {
+	return o.msg.Sprintf(key, args...)
+}
+
+// N mengembalikan format pesan key dalam bentuk tunggal atau jamak sesuai n.
+func (o *Output) N(key string, n int) string {
+	return o.msg.N(key, n)
+}
+
+// Error menerjemahkan err jika err membungkus error yang dikenal katalog,
+// dan mengembalikan err.Error() jika tidak. Hanya pesan sentinel yang
+// diganti; konteks dari pembungkusnya tetap ada, misalnya "bagi 10 dengan 0:
+// <pesan sentinel dalam locale o>". Jika pesan sentinel tidak muncul di
+// err.Error(), yang dikembalikan terjemahan sentinel pertama menurut
+// urutan errorKeys.
+func (o *Output) Error(err error) string {
+	msg := err.Error()
+	fallback := ""
+	replaced := false
+	for _, k := range errorKeys {
+		if !errors.Is(err, k.err) {
+			continue
+		}
+		if text := k.err.Error(); strings.Contains(msg, text) {
+			msg = strings.ReplaceAll(msg, text, o.T(k.key))
+			replaced = true
+		} else if fallback == "" {
+			fallback = o.T(k.key)
+		}
+	}
+	if !replaced && fallback != "" {
+		return fallback
+	}
+	return msg
+}
+
+// Observe memanggil fn untuk setiap record yang dicatat, apa pun formatnya,
+// misalnya untuk mengetahui fungsi mana saja yang dipanggil sebuah section.
+// fn dipanggil sambil memegang kunci Output sehingga tidak boleh menulis ke o.
+func (o *Output) Observe(fn func(Record)) {
+	o.observe = fn
+}
+
+// SetClock mengganti sumber waktu yang dipakai demo, termasuk untuk
+// jeda, timer dan timeout.
+func (o *Output) SetClock(c clock.Clock) {
+	o.clock = c
+}
+
+// Clock mengembalikan sumber waktu Output. Demo memakainya untuk semua
+// jeda dan timeout agar test bisa menggantinya.
+func (o *Output) Clock() clock.Clock {
+	return o.clock
+}
+
+// SetSeed membuat sumber angka acak demo deterministik.
+func (o *Output) SetSeed(seed uint64) {
+	o.rand = rand.New(rand.NewPCG(seed, seed))
+}
+
+// Now mengembalikan waktu sekarang menurut clock Output.
+func (o *Output) Now() time.Time {
+	return o.clock.Now()
+}
+
+// Rand mengembalikan sumber angka acak Output. Rand tidak aman dipakai
+// bersamaan dari banyak goroutine.
+func (o *Output) Rand() *rand.Rand {
+	return o.rand
+}
+
+// Format mengembalikan format keluaran o.
+func (o *Output) Format() Format {
+	return o.format
+}
+
+// Printf menulis teks penjelasan. Teks ini hanya muncul di FormatText.
+func (o *Output) Printf(format string, a ...any) {
+	if o.format != FormatText {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_, err := fmt.Fprintf(o.w, format, a...)
+	o.keepErr(err)
+}
+
+// Print seperti Printf tetapi menulis s apa adanya, untuk pesan katalog
+// yang sudah diterjemahkan.
+func (o *Output) Print(s string) {
+	o.Printf("%s", s)
+}
+
+// Emit mencatat r. Di FormatText yang ditulis adalah teks dari format dan
+// a; di format JSON yang ditulis adalah r.
+func (o *Output) Emit(r Record, format string, a ...any) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	r.Demo, r.Section = o.demo, o.section
+	if o.observe != nil {
+		o.observe(r)
+	}
+	if o.format == FormatText {
+		_, err := fmt.Fprintf(o.w, format, a...)
+		o.keepErr(err)
+		return
+	}
+	if o.format == FormatJSONL {
+		o.keepErr(json.NewEncoder(o.w).Encode(r))
+		return
+	}
+	o.records = append(o.records, r)
+}
+
+// keepErr menyimpan err jika belum ada error tulis sebelumnya. Dipanggil
+// sambil memegang o.mu.
+func (o *Output) keepErr(err error) {
+	if o.err == nil {
+		o.err = err
+	}
+}
+
+// Logf mencatat satu baris yang dicetak oleh function, misalnya pesan dari
+// defer atau goroutine.
+func (o *Output) Logf(function, format string, a ...any) {
+	line := fmt.Sprintf(format, a...)
+	o.Log(function, line)
+}
+
+// Log seperti Logf tetapi mencatat line apa adanya.
+func (o *Output) Log(function, line string) {
+	o.Emit(Call(function, nil, strings.TrimRight(line, "\n")), "%s", line)
+}
+
+// Flush menulis semua record yang tertunda. Hanya FormatJSON yang menunda
+// penulisan; format lain langsung ditulis. Flush mengembalikan error tulis
+// pertama sejak Output dibuat, termasuk dari Printf dan Emit sebelumnya,
+// misalnya karena pipe sudah ditutup.
+func (o *Output) Flush() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.format == FormatJSON {
+		records := o.records
+		if records == nil {
+			records = []Record{}
+		}
+		o.records = nil
+		enc := json.NewEncoder(o.w)
+		enc.SetIndent("", "  ")
+		o.keepErr(enc.Encode(records))
+	}
+	return o.err
+}
+
+func (o *Output) begin(demo, section string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.demo, o.section = demo, section
+}
@@ -0,0 +1,66 @@
+package demo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"learn-go/i18n"
+	"learn-go/mathx"
+)
+
+var errClosedPipe = errors.New("pipe ditutup")
+
+// failWriter gagal pada setiap penulisan setelah n byte.
+type failWriter struct{ n int }
+
+func (w *failWriter) Write(p []byte) (int, error) {
+	if len(p) > w.n {
+		written := w.n
+		w.n = 0
+		return written, errClosedPipe
+	}
+	w.n -= len(p)
+	return len(p), nil
+}
+
+func TestFlushReturnsWriteError(t *testing.T) {
+	for _, f := range []Format{FormatText, FormatJSON, FormatJSONL} {
+		t.Run(string(f), func(t *testing.T) {
+			o := NewOutput(&failWriter{n: 10}, f)
+			for i := range 5 {
+				o.Emit(Call("fn", i, i*2), "hasil %d\n", i*2)
+			}
+			if err := o.Flush(); !errors.Is(err, errClosedPipe) {
+				t.Errorf("Flush = %v, want %v", err, errClosedPipe)
+			}
+		})
+	}
+
+	o := NewOutput(&failWriter{n: 1 << 20}, FormatJSONL)
+	o.Emit(Call("fn", 1, 2), "%d\n", 2)
+	if err := o.Flush(); err != nil {
+		t.Errorf("Flush tanpa error tulis = %v", err)
+	}
+}
+
+func TestOutputError(t *testing.T) {
+	o := NewOutput(&failWriter{}, FormatText)
+	o.SetLocale(i18n.English)
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{mathx.ErrDivisionByZero, "cannot divide by zero"},
+		{fmt.Errorf("bagi 10 dengan 0: %w", mathx.ErrDivisionByZero), "bagi 10 dengan 0: cannot divide by zero"},
+		// Semua sentinel yang terbungkus ikut diterjemahkan.
+		{fmt.Errorf("job: %w: %w", context.Canceled, errNegativeJob), "job: canceled: job must not be negative"},
+		{errors.New("tidak dikenal"), "tidak dikenal"},
+	}
+	for _, tt := range tests {
+		if got := o.Error(tt.err); got != tt.want {
+			t.Errorf("Error(%q) = %q, want %q", tt.err, got, tt.want)
+		}
+	}
+}
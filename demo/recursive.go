@@ -0,0 +1,87 @@
+package demo
+
+import (
+	"fmt"
+	"strings"
+
+	"learn-go/collections"
+	"learn-go/mathx"
+	"learn-go/textutil"
+)
+
+func init() {
+	Register(Demo{
+		ID:       "recursive",
+		Order:    3,
+		Title:    Title{ID: "Fungsi Rekursif", EN: "Recursive Functions"},
+		Category: "basics",
+		Description: Title{
+			ID: "Factorial, fibonacci, pangkat, GCD, reverse string dan binary search",
+			EN: "Factorial, fibonacci, power, GCD, string reversal and binary search",
+		},
+		Sections: []Section{
+			{Title{ID: "Faktorial", EN: "Factorial"}, recursiveFactorial},
+			{Title{ID: "Fibonacci", EN: "Fibonacci"}, recursiveFibonacci},
+			{Title{ID: "Pangkat", EN: "Power"}, recursivePower},
+			{Title{ID: "Faktor Persekutuan Terbesar", EN: "Greatest Common Divisor"}, recursiveGCD},
+			{Title{ID: "Jumlah Array (Rekursif)", EN: "Sum Array (Recursive)"}, recursiveSumArray},
+			{Title{ID: "Membalik String", EN: "Reverse String"}, recursiveReverse},
+			{Title{ID: "Binary Search", EN: "Binary Search"}, recursiveBinarySearch},
+		},
+	})
+}
+
+func recursiveFactorial(o *Output) {
+	for i := 1; i <= 5; i++ {
+		f := mathx.Factorial(i)
+		o.Emit(Call("mathx.Factorial", i, f), o.T("recursive.factorial"), i, f)
+	}
+}
+
+func recursiveFibonacci(o *Output) {
+	var seq []int
+	var b strings.Builder
+	for i := 0; i < 10; i++ {
+		n := mathx.Fibonacci(i)
+		seq = append(seq, n)
+		fmt.Fprintf(&b, "%d ", n)
+	}
+	o.Emit(Call("mathx.Fibonacci", "0..9", seq), o.N("recursive.fibonacci", len(seq)), len(seq), b.String())
+}
+
+func recursivePower(o *Output) {
+	o.Emit(Call("mathx.Power", args(2, 3), mathx.Power(2, 3)), "2^3 = %d\n", mathx.Power(2, 3))
+	o.Emit(Call("mathx.Power", args(5, 4), mathx.Power(5, 4)), "5^4 = %d\n", mathx.Power(5, 4))
+}
+
+func recursiveGCD(o *Output) {
+	o.Emit(Call("mathx.GCD", args(48, 18), mathx.GCD(48, 18)), "GCD(48, 18) = %d\n", mathx.GCD(48, 18))
+	o.Emit(Call("mathx.GCD", args(100, 25), mathx.GCD(100, 25)), "GCD(100, 25) = %d\n", mathx.GCD(100, 25))
+}
+
+func recursiveSumArray(o *Output) {
+	numbers := []int{1, 2, 3, 4, 5}
+	sum := collections.SumArray(numbers)
+	o.Printf(o.T("common.array"), numbers)
+	o.Emit(Call("collections.SumArray", numbers, sum), o.T("common.sum"), sum)
+}
+
+func recursiveReverse(o *Output) {
+	original := "Hello"
+	reversed := textutil.ReverseRecursive(original)
+	o.Printf(o.T("common.original"), original)
+	o.Emit(Call("textutil.ReverseRecursive", original, reversed), o.T("recursive.reversed"), reversed)
+}
+
+func recursiveBinarySearch(o *Output) {
+	sortedArray := []int{1, 3, 5, 7, 9, 11, 13, 15, 17, 19}
+	target := 7
+	index := collections.BinarySearch(sortedArray, target)
+	o.Printf(o.T("common.array"), sortedArray)
+	r := Call("collections.BinarySearch", args(sortedArray, target), index)
+	if index != -1 {
+		o.Emit(r, o.T("recursive.search_found"), target, index)
+	} else {
+		o.Emit(r, o.T("recursive.search_not_found"), target)
+	}
+}
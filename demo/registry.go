@@ -0,0 +1,151 @@
+package demo
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"learn-go/i18n"
+)
+
+// Title menyimpan judul demo atau section dalam bahasa Indonesia dan Inggris.
+type Title struct {
+	ID string // Bahasa Indonesia
+	EN string // English
+}
+
+// In mengembalikan judul dalam locale l.
+func (t Title) In(l i18n.Locale) string {
+	if l == i18n.English {
+		return t.EN
+	}
+	return t.ID
+}
+
+// String mengembalikan judul dengan format "English (Indonesia)".
+func (t Title) String() string {
+	return fmt.Sprintf("%s (%s)", t.EN, t.ID)
+}
+
+// Section adalah satu bagian bernomor di dalam demo.
+type Section struct {
+	Title Title
+	Run   func(o *Output)
+}
+
+// Demo adalah satu kategori contoh yang terdaftar di registry.
+type Demo struct {
+	ID          string // identifier untuk CLI, misalnya "concurrency"
+	Order       int    // posisi di menu, dimulai dari 1
+	Title       Title
+	Category    string // kelompok materi: "basics", "data", "advanced" atau "practice"
+	Description Title
+	Sections    []Section
+}
+
+// Run menjalankan semua section d secara berurutan.
+func (d Demo) Run(o *Output) {
+	o.begin(d.ID, "")
+	o.Printf("=== %s ===\n", d.Heading(o.Locale()))
+	for i := range d.Sections {
+		if i > 0 {
+			o.Printf("\n")
+		}
+		d.runSection(o, i)
+	}
+	o.Printf("\n")
+}
+
+// Heading mengembalikan judul yang dicetak sebelum demo, yaitu judul dalam
+// locale l dengan huruf kapital.
+func (d Demo) Heading(l i18n.Locale) string {
+	return strings.ToUpper(d.Title.In(l))
+}
+
+// RunSection hanya menjalankan section ke-n (dimulai dari 1).
+func (d Demo) RunSection(o *Output, n int) error {
+	if n < 1 || n > len(d.Sections) {
+		return fmt.Errorf(o.T("demo.error.no_section"), d.ID, n, len(d.Sections))
+	}
+	d.runSection(o, n-1)
+	return nil
+}
+
+func (d Demo) runSection(o *Output, i int) {
+	s := d.Sections[i]
+	title := s.Title.In(o.Locale())
+	o.begin(d.ID, title)
+	o.Printf("%d. %s:\n", i+1, title)
+	s.Run(o)
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Demo)
+)
+
+// Register menambahkan d ke registry. Register dipanggil dari init() setiap
+// file demo dan panic jika ID kosong, tidak ada section, atau ID sudah
+// terdaftar.
+func Register(d Demo) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if d.ID == "" {
+		panic("demo: Register dengan ID kosong")
+	}
+	if len(d.Sections) == 0 {
+		panic("demo: Register " + d.ID + " tanpa section")
+	}
+	if _, dup := registry[d.ID]; dup {
+		panic("demo: Register dipanggil dua kali untuk " + d.ID)
+	}
+	registry[d.ID] = d
+}
+
+// All mengembalikan semua demo terurut berdasarkan Order lalu ID.
+func All() []Demo {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	demos := make([]Demo, 0, len(registry))
+	for _, d := range registry {
+		demos = append(demos, d)
+	}
+	sort.Slice(demos, func(i, j int) bool {
+		if demos[i].Order != demos[j].Order {
+			return demos[i].Order < demos[j].Order
+		}
+		return demos[i].ID < demos[j].ID
+	})
+	return demos
+}
+
+// Lookup mencari demo berdasarkan ID atau nomor urut di menu (dimulai
+// dari 1).
+func Lookup(key string) (Demo, bool) {
+	demos := All()
+	if n, err := strconv.Atoi(key); err == nil {
+		if n >= 1 && n <= len(demos) {
+			return demos[n-1], true
+		}
+		return Demo{}, false
+	}
+	for _, d := range demos {
+		if d.ID == key {
+			return d, true
+		}
+	}
+	return Demo{}, false
+}
+
+// RunAll menjalankan semua demo sesuai urutan menu.
+func RunAll(o *Output) {
+	o.Printf("=== %s ===\n\n", o.T("demo.run_all.start"))
+	for _, d := range All() {
+		d.Run(o)
+	}
+	o.Printf("=== %s ===\n\n", o.T("demo.run_all.done"))
+}
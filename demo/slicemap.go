@@ -0,0 +1,87 @@
+package demo
+
+import (
+	"sort"
+
+	"learn-go/collections"
+	"learn-go/textutil"
+)
+
+func init() {
+	Register(Demo{
+		ID:       "slicemap",
+		Order:    5,
+		Title:    Title{ID: "Operasi Slice dan Map", EN: "Slice & Map Functions"},
+		Category: "data",
+		Description: Title{
+			ID: "Pencarian, filter, hapus duplikasi, hitung kata dan operasi map",
+			EN: "Searching, filtering, deduplication, word counting and map operations",
+		},
+		Sections: []Section{
+			{Title{ID: "Operasi Slice", EN: "Slice Operations"}, sliceOperations},
+			{Title{ID: "Operasi Map", EN: "Map Operations"}, mapOperations},
+		},
+	})
+}
+
+func sliceOperations(o *Output) {
+	numbers := []int{3, 7, 2, 9, 1, 5, 7, 2, 9}
+	o.Printf(o.T("slicemap.original"), numbers)
+	o.Emit(Call("collections.FindMax", numbers, collections.FindMax(numbers)), o.T("slicemap.max"), collections.FindMax(numbers))
+	o.Emit(Call("collections.FindMin", numbers, collections.FindMin(numbers)), o.T("slicemap.min"), collections.FindMin(numbers))
+	o.Emit(Call("collections.Average", numbers, collections.Average(numbers)), o.T("slicemap.average"), collections.Average(numbers))
+
+	// Filter slice
+	evenNumbers := collections.Filter(numbers, func(n int) bool { return n%2 == 0 })
+	o.Emit(Call("collections.Filter", args(numbers, "n%2 == 0"), evenNumbers), o.T("slicemap.even"), evenNumbers)
+
+	greaterThan5 := collections.Filter(numbers, func(n int) bool { return n > 5 })
+	o.Emit(Call("collections.Filter", args(numbers, "n > 5"), greaterThan5), o.T("slicemap.greater_than"), 5, greaterThan5)
+
+	// Remove duplicates
+	unique := collections.RemoveDuplicates(numbers)
+	o.Emit(Call("collections.RemoveDuplicates", numbers, unique), o.T("slicemap.unique"), unique)
+
+	// Contains check
+	for _, n := range []int{7, 10} {
+		found := collections.Contains(numbers, n)
+		o.Emit(Call("collections.Contains", args(numbers, n), found), o.T("slicemap.contains"), n, found)
+	}
+
+	// Generate range
+	range1to10 := collections.GenerateRange(1, 10)
+	o.Emit(Call("collections.GenerateRange", args(1, 10), range1to10), o.T("slicemap.range"), 1, 10, range1to10)
+}
+
+func mapOperations(o *Output) {
+	text := "hello world hello go world programming go"
+	wordCount := textutil.CountWords(text)
+	o.Emit(Call("textutil.CountWords", text, wordCount), o.T("slicemap.word_count"), wordCount)
+
+	// Get keys and values
+	keys := collections.Keys(wordCount)
+	values := collections.Values(wordCount)
+	sort.Strings(keys) // Sort keys for consistent output
+	sort.Ints(values)
+	o.Emit(Call("collections.Keys", wordCount, keys), o.T("slicemap.keys"), keys)
+	o.Emit(Call("collections.Values", wordCount, values), o.T("slicemap.values"), values)
+
+	// Check key existence
+	for _, key := range []string{"hello", "python"} {
+		has := collections.HasKey(wordCount, key)
+		o.Emit(Call("collections.HasKey", args(wordCount, key), has), o.T("slicemap.has_key"), key, has)
+	}
+
+	// Merge maps
+	map1 := map[string]int{"apple": 3, "banana": 2}
+	map2 := map[string]int{"orange": 1, "apple": 1}
+	merged := collections.MergeMaps(map1, map2)
+	o.Printf("Map1: %v\n", map1)
+	o.Printf("Map2: %v\n", map2)
+	o.Emit(Call("collections.MergeMaps", args(map1, map2), merged), o.T("slicemap.merged"), merged)
+
+	// Reverse map
+	reversed := collections.ReverseMap(map1)
+	o.Printf(o.T("slicemap.original_map"), map1)
+	o.Emit(Call("collections.ReverseMap", map1, reversed), o.T("slicemap.reversed_map"), reversed)
+}
@@ -0,0 +1,115 @@
+package demo
+
+import (
+	"errors"
+
+	"learn-go/apperr"
+	"learn-go/people"
+	"learn-go/shapes"
+)
+
+func init() {
+	Register(Demo{
+		ID:       "structs",
+		Order:    4,
+		Title:    Title{ID: "Struct dan Interface", EN: "Struct & Methods"},
+		Category: "basics",
+		Description: Title{
+			ID: "Value dan pointer receiver, interface, polymorphism, embedded struct dan validasi",
+			EN: "Value and pointer receivers, interfaces, polymorphism, embedded structs and validation",
+		},
+		Sections: []Section{
+			{Title{ID: "Struct dan Method Dasar", EN: "Basic Struct and Methods"}, structsBasic},
+			{Title{ID: "Interface", EN: "Interface"}, structsInterface},
+			{Title{ID: "Embedded Struct", EN: "Embedded Struct"}, structsEmbedded},
+			{Title{ID: "Validasi Struct", EN: "Struct Validation"}, structsValidation},
+		},
+	})
+}
+
+// personInfo menampilkan data yang sama dengan Person.GetInfo dalam locale o.
+func personInfo(o *Output, p people.Person) string {
+	return o.Sprintf("structs.person_info", p.Name, p.Age, p.Email)
+}
+
+func structsBasic(o *Output) {
+	person := people.Person{Name: "Alice", Age: 25, Email: "alice@example.com"}
+	o.Emit(Call("people.Person.GetInfo", person, person.GetInfo()), "%s\n", personInfo(o, person))
+	o.Emit(Call("people.Person.IsAdult", person, person.IsAdult()), o.T("structs.is_adult"), person.IsAdult())
+
+	// Pointer receiver methods; setter mengembalikan error jika nilai tidak valid
+	if err := person.SetAge(26); err != nil {
+		printViolations(o, err)
+	}
+	if err := person.SetEmail("alice.new@example.com"); err != nil {
+		printViolations(o, err)
+	}
+	o.Emit(Call("people.Person.GetInfo", person, person.GetInfo()), o.T("structs.after_update"), personInfo(o, person))
+}
+
+func structsInterface(o *Output) {
+	shapeList := []shapes.Shape{
+		shapes.Rectangle{Width: 5, Height: 3},
+		shapes.Circle{Radius: 4},
+		shapes.Triangle{Base: 6, Height: 4, Side1: 5, Side2: 5},
+	}
+
+	for _, shape := range shapeList {
+		o.Emit(Call("shapes.Describe", shape, shapes.Describe(shape)), o.T("structs.shape_info"),
+			o.T("shape."+shape.GetType()), shape.Area(), shape.Perimeter())
+	}
+
+	totalArea := shapes.TotalArea(shapeList)
+	o.Emit(Call("shapes.TotalArea", shapeList, totalArea), o.T("structs.total_area"), totalArea)
+}
+
+func structsEmbedded(o *Output) {
+	employee := people.Employee{
+		Person:   people.Person{Name: "Bob", Age: 30, Email: "bob@company.com"},
+		Address:  people.Address{Street: "123 Main St", City: "New York", ZipCode: "10001"},
+		Salary:   75000,
+		JobTitle: "Software Engineer",
+	}
+
+	o.Emit(Call("people.Employee.GetFullInfo", employee, employee.GetFullInfo()), o.T("structs.employee_info"),
+		employee.Name, employee.JobTitle, employee.Street, employee.City, employee.Salary)
+	// Dapat mengakses field embedded langsung
+	o.Printf(o.T("structs.employee_name"), employee.Name)
+	o.Printf(o.T("structs.employee_city"), employee.City)
+}
+
+func structsValidation(o *Output) {
+	employee := people.Employee{
+		Person:   people.Person{Name: "", Age: 200, Email: "budi@"},
+		Address:  people.Address{Street: "Jl. Merdeka 1", City: "Bandung", ZipCode: "4011"},
+		Salary:   -500000,
+		JobTitle: "Software Engineer",
+	}
+	// Semua pelanggaran dikumpulkan dalam satu error, bukan berhenti di
+	// yang pertama.
+	err := employee.Validate()
+	n := len(violations(err))
+	o.Emit(Call("people.Employee.Validate", employee, nil).WithError(err), o.N("structs.violations", n), n)
+	printViolations(o, err)
+
+	person := people.Person{Name: "Alice", Age: 25, Email: "alice@example.com"}
+	err = person.SetAge(-3)
+	o.Emit(Call("people.Person.SetAge", -3, person.Age).WithError(err), o.T("structs.set_age_rejected"), -3, person.Age)
+	printViolations(o, err)
+}
+
+// violations mengembalikan detail per field dari error validasi.
+func violations(err error) []apperr.Field {
+	var e *apperr.Error
+	if !errors.As(err, &e) {
+		return nil
+	}
+	return e.Fields
+}
+
+// printViolations menampilkan setiap pelanggaran di err dalam locale o.
+func printViolations(o *Output, err error) {
+	for _, f := range violations(err) {
+		o.Printf("  - %s: %s\n", f.Name, o.T("structs.rule."+f.Rule))
+	}
+}
@@ -0,0 +1,121 @@
+package demo
+
+import (
+	"time"
+
+	"learn-go/mathx"
+	"learn-go/textutil"
+	"learn-go/timeutil"
+	"learn-go/validate"
+)
+
+func init() {
+	Register(Demo{
+		ID:       "utility",
+		Order:    8,
+		Title:    Title{ID: "Fungsi Utilitas", EN: "Utility Functions"},
+		Category: "practice",
+		Description: Title{
+			ID: "Utilitas string, matematika, tanggal, validasi dan konversi",
+			EN: "String, math, date, validation and conversion utilities",
+		},
+		Sections: []Section{
+			{Title{ID: "Utilitas String", EN: "String Utilities"}, utilityString},
+			{Title{ID: "Utilitas Matematika", EN: "Math Utilities"}, utilityMath},
+			{Title{ID: "Utilitas Angka", EN: "Number Utilities"}, utilityNumber},
+			{Title{ID: "Utilitas Tanggal/Waktu", EN: "Date/Time Utilities"}, utilityDateTime},
+			{Title{ID: "Utilitas Validasi", EN: "Validation Utilities"}, utilityValidation},
+			{Title{ID: "Utilitas Konversi", EN: "Conversion Utilities"}, utilityConversion},
+		},
+	})
+}
+
+func utilityString(o *Output) {
+	email := "user@example.com"
+	o.Emit(Call("validate.IsValidEmail", email, validate.IsValidEmail(email)),
+		o.T("utility.email_valid"), email, validate.IsValidEmail(email))
+	o.Emit(Call("validate.IsValidEmailRegex", email, validate.IsValidEmailRegex(email)),
+		o.T("utility.email_valid_regex"), email, validate.IsValidEmailRegex(email))
+
+	messy := "   hello    world   go   "
+	cleaned := textutil.CleanString(messy)
+	o.Printf(o.T("utility.original_quoted"), messy)
+	o.Emit(Call("textutil.CleanString", messy, cleaned), o.T("utility.cleaned"), cleaned)
+
+	name := "john doe smith"
+	o.Emit(Call("textutil.ToTitleCase", name, textutil.ToTitleCase(name)), o.T("utility.title_case"), textutil.ToTitleCase(name))
+
+	word := "racecar"
+	o.Emit(Call("textutil.IsPalindrome", word, textutil.IsPalindrome(word)),
+		o.T("utility.palindrome"), word, textutil.IsPalindrome(word))
+}
+
+func utilityMath(o *Output) {
+	celsius := 25.0
+	fahrenheit := mathx.CelsiusToFahrenheit(celsius)
+	kelvin := mathx.CelsiusToKelvin(celsius)
+	o.Emit(Call("mathx.CelsiusToFahrenheit", celsius, fahrenheit), "%.1f°C = %.1f°F\n", celsius, fahrenheit)
+	o.Emit(Call("mathx.CelsiusToKelvin", celsius, kelvin), "%.1f°C = %.1f K\n", celsius, kelvin)
+
+	radius := 5.0
+	area := mathx.RoundToDecimal(mathx.CircleArea(radius), 2)
+	volume := mathx.RoundToDecimal(mathx.SphereVolume(radius), 2)
+	o.Emit(Call("mathx.CircleArea", radius, area), o.T("utility.circle_area"), radius, area)
+	o.Emit(Call("mathx.SphereVolume", radius, volume), o.T("utility.sphere_volume"), radius, volume)
+
+	dist := mathx.Distance(0, 0, 3, 4)
+	o.Emit(Call("mathx.Distance", args(0, 0, 3, 4), dist), o.T("utility.distance"), 0, 0, 3, 4, dist)
+}
+
+func utilityNumber(o *Output) {
+	num := 17
+	o.Emit(Call("mathx.IsPrime", num, mathx.IsPrime(num)), o.T("utility.is_prime"), num, mathx.IsPrime(num))
+
+	primes := mathx.GeneratePrimes(20)
+	o.Emit(Call("mathx.GeneratePrimes", 20, primes), o.T("utility.primes_up_to"), 20, primes)
+
+	bigNumber := 1234567
+	formatted := textutil.FormatNumber(bigNumber)
+	o.Emit(Call("textutil.FormatNumber", bigNumber, formatted), o.T("utility.formatted_number"), formatted)
+
+	decimal := 3.14159265
+	rounded := mathx.RoundToDecimal(decimal, 2)
+	o.Emit(Call("mathx.RoundToDecimal", args(decimal, 2), rounded), o.N("utility.rounded", 2), 2, rounded)
+}
+
+func utilityDateTime(o *Output) {
+	now := o.Now()
+	for _, layout := range []string{"DD/MM/YYYY", "DD Mon YYYY"} {
+		formatted := timeutil.FormatDate(now, layout)
+		o.Emit(Call("timeutil.FormatDate", args("now", layout), formatted),
+			o.T("utility.current_time"), layout, formatted)
+	}
+
+	birthDate := time.Date(1990, 5, 15, 0, 0, 0, 0, time.UTC)
+	age := timeutil.AgeAt(birthDate, now)
+	o.Emit(Call("timeutil.AgeAt", args("1990-05-15", "now"), age),
+		o.N("utility.age", age), timeutil.FormatDate(birthDate, "DD/MM/YYYY"), age)
+
+	o.Emit(Call("timeutil.IsLeapYear", 2024, timeutil.IsLeapYear(2024)),
+		o.T("utility.leap_year"), 2024, timeutil.IsLeapYear(2024))
+}
+
+func utilityValidation(o *Output) {
+	phones := []string{"081234567890", "+6281234567890", "021-12345678"}
+	for _, phone := range phones {
+		valid := validate.IsValidPhoneNumber(phone)
+		o.Emit(Call("validate.IsValidPhoneNumber", phone, valid), o.T("utility.phone_valid"), phone, valid)
+	}
+
+	nik := "1234567890123456"
+	o.Emit(Call("validate.IsValidNIK", nik, validate.IsValidNIK(nik)), o.T("utility.nik_valid"), nik, validate.IsValidNIK(nik))
+}
+
+func utilityConversion(o *Output) {
+	bytes := int64(1024*1024*500 + 1024*256) // 500.25 MB
+	human := textutil.BytesToHuman(bytes)
+	o.Emit(Call("textutil.BytesToHuman", bytes, human), o.N("utility.bytes", int(bytes)), bytes, human)
+
+	randomStr := textutil.RandomString(o.Rand(), 8)
+	o.Emit(Call("textutil.RandomString", 8, randomStr), o.T("utility.random_string"), 8, randomStr)
+}
@@ -0,0 +1,153 @@
+// Package exercise berisi latihan untuk setiap kategori demo. Setiap latihan
+// punya fungsi stub yang harus dilengkapi, test tersembunyi yang dikompilasi
+// dan dijalankan secara lokal dengan `go test`, petunjuk bertahap dan skor.
+package exercise
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"learn-go/demo"
+)
+
+// tasks menyimpan stub dan test tersembunyi setiap latihan di
+// tasks/<kategori>/<nama>/. Ekstensi .tmpl mencegah file tersebut ikut
+// dikompilasi sebagai bagian dari modul ini.
+//
+//go:embed tasks/*/*/stub.go.tmpl tasks/*/*/hidden_test.go.tmpl
+var tasks embed.FS
+
+// Nama file di direktori kerja dan di direktori test sementara.
+const (
+	SolutionFile   = "solution.go"
+	hiddenTestFile = "hidden_test.go"
+)
+
+// DefaultPoints adalah skor maksimal latihan jika Points tidak diisi.
+const DefaultPoints = 10
+
+// HintPenalty adalah pengurangan skor untuk setiap petunjuk yang dibuka.
+const HintPenalty = 3
+
+// Exercise adalah satu latihan yang terdaftar di registry.
+type Exercise struct {
+	ID     string // "<kategori>/<nama>"; kategori sama dengan ID demo
+	Title  demo.Title
+	Prompt demo.Title
+	Hints  []demo.Title
+	Points int // skor maksimal; 0 berarti DefaultPoints
+
+	seq int // urutan pendaftaran di dalam kategori
+}
+
+// Category mengembalikan ID demo tempat latihan ini berada.
+func (e Exercise) Category() string {
+	category, _, _ := strings.Cut(e.ID, "/")
+	return category
+}
+
+// MaxPoints mengembalikan skor maksimal e.
+func (e Exercise) MaxPoints() int {
+	if e.Points == 0 {
+		return DefaultPoints
+	}
+	return e.Points
+}
+
+// Score menghitung skor e setelah lulus dengan hintsUsed petunjuk terbuka.
+// Latihan yang lulus selalu bernilai minimal 1.
+func (e Exercise) Score(hintsUsed int) int {
+	return max(e.MaxPoints()-hintsUsed*HintPenalty, 1)
+}
+
+// Stub mengembalikan isi solution.go awal untuk e.
+func (e Exercise) Stub() []byte {
+	return e.file("stub.go.tmpl")
+}
+
+func (e Exercise) hiddenTest() []byte {
+	return e.file("hidden_test.go.tmpl")
+}
+
+func (e Exercise) file(name string) []byte {
+	b, err := tasks.ReadFile(path.Join("tasks", e.ID, name))
+	if err != nil {
+		panic("exercise: " + err.Error())
+	}
+	return b
+}
+
+var (
+	mu       sync.RWMutex
+	registry = make(map[string]Exercise)
+)
+
+// Register menambahkan e ke registry. Register dipanggil dari init() dan
+// panic jika ID sudah dipakai, kategorinya bukan demo terdaftar, atau file
+// stub dan test tersembunyinya tidak ada.
+func Register(e Exercise) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, dup := registry[e.ID]; dup {
+		panic("exercise: Register dipanggil dua kali untuk " + e.ID)
+	}
+	if _, ok := demo.Lookup(e.Category()); !ok {
+		panic("exercise: kategori tidak dikenal untuk " + e.ID)
+	}
+	for _, name := range []string{"stub.go.tmpl", "hidden_test.go.tmpl"} {
+		if _, err := tasks.Open(path.Join("tasks", e.ID, name)); err != nil {
+			panic(fmt.Sprintf("exercise: %s tidak punya %s", e.ID, name))
+		}
+	}
+	for _, other := range registry {
+		if other.Category() == e.Category() {
+			e.seq++
+		}
+	}
+	registry[e.ID] = e
+}
+
+// All mengembalikan semua latihan, terurut sesuai urutan demo lalu urutan
+// pendaftaran.
+func All() []Exercise {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	all := make([]Exercise, 0, len(registry))
+	for _, e := range registry {
+		all = append(all, e)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		a, _ := demo.Lookup(all[i].Category())
+		b, _ := demo.Lookup(all[j].Category())
+		if a.Order != b.Order {
+			return a.Order < b.Order
+		}
+		return all[i].seq < all[j].seq
+	})
+	return all
+}
+
+// InCategory mengembalikan latihan untuk demo category.
+func InCategory(category string) []Exercise {
+	var list []Exercise
+	for _, e := range All() {
+		if e.Category() == category {
+			list = append(list, e)
+		}
+	}
+	return list
+}
+
+// Lookup mencari latihan berdasarkan ID.
+func Lookup(id string) (Exercise, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	e, ok := registry[id]
+	return e, ok
+}
@@ -0,0 +1,116 @@
+package exercise
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"learn-go/demo"
+)
+
+// TestTasks memastikan test tersembunyi setiap latihan gagal untuk stub dan
+// lulus untuk solusi acuan di tasks/<id>/solution.go.tmpl.
+func TestTasks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("menjalankan go test untuk setiap latihan; dilewati dengan -short")
+	}
+	for _, e := range All() {
+		t.Run(e.ID, func(t *testing.T) {
+			t.Parallel()
+
+			solution, err := os.ReadFile(filepath.Join("tasks", e.ID, "solution.go.tmpl"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, tc := range []struct {
+				name string
+				src  []byte
+				pass bool
+			}{
+				{"stub", e.Stub(), false},
+				{"solution", solution, true},
+			} {
+				dir := t.TempDir()
+				if err := os.WriteFile(filepath.Join(dir, SolutionFile), tc.src, 0o644); err != nil {
+					t.Fatal(err)
+				}
+				res, err := runHidden(context.Background(), e, dir)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.Passed != tc.pass {
+					t.Errorf("%s: lulus = %v, want %v\n%s", tc.name, res.Passed, tc.pass, res.Output)
+				}
+			}
+		})
+	}
+}
+
+func TestEveryCategoryHasExercises(t *testing.T) {
+	for _, d := range demo.All() {
+		if len(InCategory(d.ID)) == 0 {
+			t.Errorf("demo %s belum punya latihan", d.ID)
+		}
+	}
+	for _, e := range All() {
+		if e.Title.ID == "" || e.Title.EN == "" || e.Prompt.ID == "" || e.Prompt.EN == "" || len(e.Hints) == 0 {
+			t.Errorf("latihan %s: judul, soal atau petunjuk belum lengkap", e.ID)
+		}
+	}
+}
+
+func TestWorkspaceStartKeepsSolution(t *testing.T) {
+	e, _ := Lookup("basic/min-max")
+	w := Workspace{Dir: t.TempDir()}
+
+	path, err := w.Start(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("package exercise // jawaban\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Start(e); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := os.ReadFile(path); string(got) != "package exercise // jawaban\n" {
+		t.Errorf("Start menimpa jawaban: %q", got)
+	}
+
+	other, _ := Lookup("basic/div-mod")
+	if _, err := w.Check(context.Background(), other); err != ErrNotStarted {
+		t.Errorf("Check latihan yang belum dimulai = %v, want ErrNotStarted", err)
+	}
+}
+
+func TestProgressScore(t *testing.T) {
+	e, _ := Lookup("recursive/flatten") // dua petunjuk
+	var p Progress
+	now := time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
+
+	if n, ok := p.NextHint(e); n != 1 || !ok {
+		t.Fatalf("NextHint = %d, %v; want 1, true", n, ok)
+	}
+	p.RecordResult(e, Result{Passed: false}, now)
+	p.RecordResult(e, Result{Passed: true}, now)
+	r := p.Exercises[e.ID]
+	if r.Attempts != 2 || !r.Passed || r.Score != DefaultPoints-HintPenalty || !r.PassedAt.Equal(now) {
+		t.Errorf("record = %+v", r)
+	}
+
+	// Petunjuk setelah lulus tidak mengurangi skor terbaik.
+	p.NextHint(e)
+	if n, ok := p.NextHint(e); n != 2 || ok {
+		t.Errorf("NextHint setelah habis = %d, %v; want 2, false", n, ok)
+	}
+	p.RecordResult(e, Result{Passed: true}, now.Add(time.Hour))
+	if r.Score != DefaultPoints-HintPenalty || !r.PassedAt.Equal(now) {
+		t.Errorf("skor terbaik atau waktu lulus berubah: %+v", r)
+	}
+
+	if score, _ := p.Total(); score != r.Score {
+		t.Errorf("Total = %d, want %d", score, r.Score)
+	}
+}
@@ -0,0 +1,72 @@
+package exercise
+
+import "time"
+
+// Record adalah progres satu latihan.
+type Record struct {
+	Attempts  int       `json:"attempts"`
+	HintsUsed int       `json:"hints_used"`
+	Passed    bool      `json:"passed"`
+	Score     int       `json:"score"` // skor terbaik
+	PassedAt  time.Time `json:"passed_at,omitzero"`
+}
+
+// Progress menyimpan progres semua latihan, dengan key ID latihan. Nilai nol
+// Progress siap dipakai; penyimpanannya diatur oleh paket progress.
+type Progress struct {
+	Exercises map[string]*Record `json:"exercises"`
+}
+
+// Get mengembalikan record untuk id, membuatnya jika belum ada.
+func (p *Progress) Get(id string) *Record {
+	r, ok := p.Exercises[id]
+	if !ok {
+		if p.Exercises == nil {
+			p.Exercises = make(map[string]*Record)
+		}
+		r = &Record{}
+		p.Exercises[id] = r
+	}
+	return r
+}
+
+// NextHint membuka petunjuk berikutnya untuk e dan mengembalikan nomornya
+// (dimulai dari 1). ok false berarti semua petunjuk sudah dibuka; petunjuk
+// terakhir dikembalikan lagi tanpa menambah penalti.
+func (p *Progress) NextHint(e Exercise) (n int, ok bool) {
+	if len(e.Hints) == 0 {
+		return 0, false
+	}
+	r := p.Get(e.ID)
+	if r.HintsUsed >= len(e.Hints) {
+		return len(e.Hints), false
+	}
+	r.HintsUsed++
+	return r.HintsUsed, true
+}
+
+// RecordResult mencatat satu percobaan e dengan hasil res pada waktu now.
+func (p *Progress) RecordResult(e Exercise, res Result, now time.Time) {
+	r := p.Get(e.ID)
+	r.Attempts++
+	if !res.Passed {
+		return
+	}
+	if !r.Passed {
+		r.PassedAt = now
+	}
+	r.Passed = true
+	r.Score = max(r.Score, e.Score(r.HintsUsed))
+}
+
+// Total mengembalikan skor yang diperoleh dan skor maksimal dari semua
+// latihan terdaftar.
+func (p *Progress) Total() (score, maxScore int) {
+	for _, e := range All() {
+		maxScore += e.MaxPoints()
+		if r, ok := p.Exercises[e.ID]; ok {
+			score += r.Score
+		}
+	}
+	return score, maxScore
+}
@@ -0,0 +1,213 @@
+package exercise
+
+import "learn-go/demo"
+
+// ========== DAFTAR LATIHAN ==========
+
+func init() {
+	// Fungsi Dasar
+	Register(Exercise{
+		ID:    "basic/min-max",
+		Title: demo.Title{ID: "Nilai Minimum dan Maksimum", EN: "Minimum and Maximum"},
+		Prompt: demo.Title{
+			ID: "Lengkapi MinMax(nums ...int) (min, max int) yang mengembalikan nilai terkecil dan terbesar dari parameter variadic. Slice kosong menghasilkan 0, 0.",
+			EN: "Complete MinMax(nums ...int) (min, max int), returning the smallest and largest of the variadic arguments. An empty list yields 0, 0.",
+		},
+		Hints: []demo.Title{
+			{ID: "Tangani len(nums) == 0 lebih dulu.", EN: "Handle len(nums) == 0 first."},
+			{ID: "Mulai dengan min dan max = nums[0], lalu bandingkan sisanya dalam satu loop.", EN: "Start with min and max = nums[0], then compare the rest in a single loop."},
+		},
+	})
+	Register(Exercise{
+		ID:    "basic/div-mod",
+		Title: demo.Title{ID: "Hasil dan Sisa Bagi", EN: "Quotient and Remainder"},
+		Prompt: demo.Title{
+			ID: "Lengkapi DivMod(a, b int) yang mengembalikan hasil bagi, sisa bagi dan error. Pembagian dengan nol harus menghasilkan error, bukan panic.",
+			EN: "Complete DivMod(a, b int), returning the quotient, remainder and an error. Dividing by zero must return an error instead of panicking.",
+		},
+		Hints: []demo.Title{
+			{ID: "Operator / dan % di Go membulatkan ke arah nol.", EN: "Go's / and % operators truncate toward zero."},
+			{ID: "Gunakan errors.New untuk membuat error saat b == 0.", EN: "Use errors.New to create the error when b == 0."},
+		},
+	})
+
+	// Fungsi Lanjutan (closure)
+	Register(Exercise{
+		ID:    "advanced/counter",
+		Title: demo.Title{ID: "Counter dengan Closure", EN: "Counter with Closures"},
+		Prompt: demo.Title{
+			ID: "Lengkapi NewCounter(step) yang mengembalikan closure next dan reset yang berbagi satu variabel count.",
+			EN: "Complete NewCounter(step), returning next and reset closures that share a single count variable.",
+		},
+		Hints: []demo.Title{
+			{ID: "Deklarasikan count di dalam NewCounter, bukan sebagai variabel global.", EN: "Declare count inside NewCounter, not as a global."},
+			{ID: "Kedua fungsi literal bisa membaca dan mengubah count yang sama.", EN: "Both function literals can read and modify the same count."},
+		},
+	})
+	Register(Exercise{
+		ID:    "advanced/compose",
+		Title: demo.Title{ID: "Komposisi Fungsi", EN: "Function Composition"},
+		Prompt: demo.Title{
+			ID: "Lengkapi Compose(fns ...func(int) int) yang mengembalikan satu fungsi yang menerapkan fns dari kiri ke kanan.",
+			EN: "Complete Compose(fns ...func(int) int), returning one function that applies fns from left to right.",
+		},
+		Hints: []demo.Title{
+			{ID: "Fungsi yang dikembalikan cukup me-loop fns dan mengganti x dengan fn(x).", EN: "The returned function can loop over fns, replacing x with fn(x)."},
+		},
+	})
+
+	// Fungsi Rekursif
+	Register(Exercise{
+		ID:    "recursive/sum-digits",
+		Title: demo.Title{ID: "Jumlah Digit", EN: "Sum of Digits"},
+		Prompt: demo.Title{
+			ID: "Lengkapi SumDigits(n) secara rekursif tanpa loop. Angka negatif memakai nilai mutlaknya.",
+			EN: "Complete SumDigits(n) recursively without loops. Negative numbers use their absolute value.",
+		},
+		Hints: []demo.Title{
+			{ID: "Base case: n < 10.", EN: "Base case: n < 10."},
+			{ID: "n%10 adalah digit terakhir dan n/10 adalah sisanya.", EN: "n%10 is the last digit and n/10 is the rest."},
+		},
+	})
+	Register(Exercise{
+		ID:    "recursive/flatten",
+		Title: demo.Title{ID: "Meratakan Slice Bersarang", EN: "Flatten a Nested Slice"},
+		Prompt: demo.Title{
+			ID: "Lengkapi Flatten(v []any) yang meratakan int dan []any bersarang menjadi satu []int.",
+			EN: "Complete Flatten(v []any), flattening nested ints and []any into a single []int.",
+		},
+		Hints: []demo.Title{
+			{ID: "Gunakan type switch: case int dan case []any.", EN: "Use a type switch: case int and case []any."},
+			{ID: "Untuk []any, panggil Flatten lagi lalu append(out, hasil...).", EN: "For []any, call Flatten again and append(out, result...)."},
+		},
+	})
+
+	// Struct dan Method
+	Register(Exercise{
+		ID:    "structs/stack",
+		Title: demo.Title{ID: "Stack dengan Pointer Receiver", EN: "Stack with Pointer Receivers"},
+		Prompt: demo.Title{
+			ID: "Lengkapi struct Stack beserta method Push, Pop dan Len. Nilai nol Stack harus langsung bisa dipakai.",
+			EN: "Complete the Stack struct with Push, Pop and Len methods. The zero value of Stack must be ready to use.",
+		},
+		Hints: []demo.Title{
+			{ID: "Satu field items []int sudah cukup; append pada slice nil tetap bekerja.", EN: "A single items []int field is enough; append works on a nil slice."},
+			{ID: "Pop mengambil items[len(items)-1] lalu memotong slice.", EN: "Pop takes items[len(items)-1] and then reslices."},
+		},
+	})
+	Register(Exercise{
+		ID:    "structs/largest-shape",
+		Title: demo.Title{ID: "Shape Terbesar", EN: "Largest Shape"},
+		Prompt: demo.Title{
+			ID: "Implementasikan Area untuk Square dan Largest(shapes []Shape) yang mengembalikan shape dengan luas terbesar.",
+			EN: "Implement Area for Square and Largest(shapes []Shape), returning the shape with the largest area.",
+		},
+		Hints: []demo.Title{
+			{ID: "Largest bekerja dengan interface, jadi jenis shape lain juga harus didukung.", EN: "Largest works on the interface, so other shape types must work too."},
+			{ID: "Simpan kandidat terbaik dalam variabel bertipe Shape yang awalnya nil.", EN: "Keep the best candidate in a Shape variable that starts as nil."},
+		},
+	})
+
+	// Slice dan Map
+	Register(Exercise{
+		ID:    "slicemap/group-by-length",
+		Title: demo.Title{ID: "Kelompokkan Berdasarkan Panjang", EN: "Group by Length"},
+		Prompt: demo.Title{
+			ID: "Lengkapi GroupByLength(words) yang mengelompokkan kata dalam map[int][]string berdasarkan jumlah huruf.",
+			EN: "Complete GroupByLength(words), grouping words into a map[int][]string by letter count.",
+		},
+		Hints: []demo.Title{
+			{ID: "Hitung huruf dengan utf8.RuneCountInString, bukan len.", EN: "Count letters with utf8.RuneCountInString, not len."},
+			{ID: "groups[n] = append(groups[n], w) bekerja walaupun key belum ada.", EN: "groups[n] = append(groups[n], w) works even when the key is missing."},
+		},
+	})
+	Register(Exercise{
+		ID:    "slicemap/top-words",
+		Title: demo.Title{ID: "Kata Terpopuler", EN: "Top Words"},
+		Prompt: demo.Title{
+			ID: "Lengkapi TopWords(text, n) yang mengembalikan n kata paling sering muncul, dengan urutan alfabet untuk jumlah yang sama.",
+			EN: "Complete TopWords(text, n), returning the n most frequent words, alphabetical on ties.",
+		},
+		Hints: []demo.Title{
+			{ID: "Hitung kata ke map[string]int setelah strings.ToLower dan strings.Fields.", EN: "Count words into a map[string]int after strings.ToLower and strings.Fields."},
+			{ID: "Urutkan key map dengan sort.Slice memakai dua kriteria.", EN: "Sort the map keys with sort.Slice using two criteria."},
+		},
+	})
+
+	// Concurrency
+	Register(Exercise{
+		ID:    "concurrency/parallel-sum",
+		Title: demo.Title{ID: "Penjumlahan Paralel", EN: "Parallel Sum"},
+		Prompt: demo.Title{
+			ID: "Lengkapi ParallelSum(nums, workers) yang membagi nums ke beberapa goroutine lalu menggabungkan hasilnya tanpa goroutine yang bocor.",
+			EN: "Complete ParallelSum(nums, workers), splitting nums across goroutines and combining the results without leaking goroutines.",
+		},
+		Hints: []demo.Title{
+			{ID: "Ukuran bagian: (len(nums) + workers - 1) / workers.", EN: "Chunk size: (len(nums) + workers - 1) / workers."},
+			{ID: "Gunakan sync.WaitGroup dan lindungi total dengan sync.Mutex, atau kirim subtotal lewat channel ber-buffer.", EN: "Use a sync.WaitGroup and guard the total with a sync.Mutex, or send subtotals over a buffered channel."},
+		},
+	})
+	Register(Exercise{
+		ID:    "concurrency/square-stage",
+		Title: demo.Title{ID: "Tahap Pipeline Kuadrat", EN: "Squaring Pipeline Stage"},
+		Prompt: demo.Title{
+			ID: "Lengkapi SquareAll(in) yang membaca dari in di goroutine dan mengirim kuadratnya ke channel keluaran, lalu menutupnya.",
+			EN: "Complete SquareAll(in), reading from in inside a goroutine, sending the squares to an output channel and closing it.",
+		},
+		Hints: []demo.Title{
+			{ID: "Buat channel keluaran, jalankan goroutine, lalu langsung kembalikan channel tersebut.", EN: "Create the output channel, start a goroutine and return the channel immediately."},
+			{ID: "Pakai defer close(out) di dalam goroutine dan for n := range in.", EN: "Use defer close(out) inside the goroutine and for n := range in."},
+		},
+	})
+
+	// Error Handling
+	Register(Exercise{
+		ID:    "errors/safe-call",
+		Title: demo.Title{ID: "Recover Menjadi Error", EN: "Recover into an Error"},
+		Prompt: demo.Title{
+			ID: "Lengkapi SafeCall(fn) yang mengubah panic di dalam fn menjadi error.",
+			EN: "Complete SafeCall(fn), turning a panic inside fn into an error.",
+		},
+		Hints: []demo.Title{
+			{ID: "recover hanya bekerja di dalam fungsi yang di-defer.", EN: "recover only works inside a deferred function."},
+			{ID: "Dengan named return value, fungsi defer bisa mengisi err.", EN: "With named return values, the deferred function can set err."},
+		},
+	})
+	Register(Exercise{
+		ID:    "errors/find-user",
+		Title: demo.Title{ID: "Membungkus Error", EN: "Wrapping Errors"},
+		Prompt: demo.Title{
+			ID: "Lengkapi FindUser(users, id) yang mengembalikan error pembungkus ErrNotFound beserta id-nya jika user tidak ada.",
+			EN: "Complete FindUser(users, id), returning an error that wraps ErrNotFound and mentions the id when the user is missing.",
+		},
+		Hints: []demo.Title{
+			{ID: "fmt.Errorf dengan verb %w membungkus error.", EN: "fmt.Errorf with the %w verb wraps an error."},
+		},
+	})
+
+	// Fungsi Utilitas
+	Register(Exercise{
+		ID:    "utility/slugify",
+		Title: demo.Title{ID: "Slug URL", EN: "URL Slug"},
+		Prompt: demo.Title{
+			ID: "Lengkapi Slugify(s) yang mengubah judul menjadi slug URL huruf kecil dengan pemisah \"-\".",
+			EN: "Complete Slugify(s), turning a title into a lower-case URL slug separated by \"-\".",
+		},
+		Hints: []demo.Title{
+			{ID: "Iterasi rune dan cek unicode.IsLetter atau unicode.IsDigit.", EN: "Iterate over runes and check unicode.IsLetter or unicode.IsDigit."},
+			{ID: "Tunda penulisan \"-\" sampai huruf berikutnya muncul agar tidak ada \"-\" di akhir.", EN: "Delay writing \"-\" until the next letter appears so there is no trailing \"-\"."},
+		},
+	})
+	Register(Exercise{
+		ID:    "utility/mask-email",
+		Title: demo.Title{ID: "Menyamarkan Email", EN: "Masking Emails"},
+		Prompt: demo.Title{
+			ID: "Lengkapi MaskEmail(email) yang menyamarkan bagian sebelum \"@\" kecuali huruf pertamanya.",
+			EN: "Complete MaskEmail(email), masking everything before \"@\" except the first letter.",
+		},
+		Hints: []demo.Title{
+			{ID: "strings.Cut(email, \"@\") memisahkan bagian lokal dan domain.", EN: "strings.Cut(email, \"@\") splits the local part and the domain."},
+			{ID: "Huruf pertama bisa lebih dari satu byte; gunakan utf8.DecodeRuneInString.", EN: "The first letter may span several bytes; use utf8.DecodeRuneInString."},
+		},
+	})
+}
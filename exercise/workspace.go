@@ -0,0 +1,170 @@
+package exercise
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// CheckTimeout membatasi lama kompilasi dan eksekusi test tersembunyi.
+const CheckTimeout = 2 * time.Minute
+
+// ErrNotStarted dikembalikan oleh Check jika latihan belum dimulai.
+var ErrNotStarted = errors.New("latihan belum dimulai")
+
+// ErrNoGo dikembalikan oleh Check jika perintah go tidak ditemukan di PATH.
+var ErrNoGo = errors.New("perintah go tidak ditemukan di PATH")
+
+// goMod dipakai untuk direktori kerja dan direktori test sementara.
+const goMod = "module exercise\n\ngo 1.22\n"
+
+// Workspace adalah direktori tempat pengguna mengerjakan latihan. Setiap
+// latihan berada di <Dir>/<kategori>/<nama>/solution.go.
+type Workspace struct {
+	Dir string
+}
+
+// DefaultDir mengembalikan direktori kerja bawaan, yaitu
+// $LEARN_GO_EXERCISES atau ~/learn-go-exercises.
+func DefaultDir() (string, error) {
+	if dir := os.Getenv("LEARN_GO_EXERCISES"); dir != "" {
+		return dir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, "learn-go-exercises"), nil
+}
+
+// TaskDir mengembalikan direktori latihan e.
+func (w Workspace) TaskDir(e Exercise) string {
+	return filepath.Join(w.Dir, filepath.FromSlash(e.ID))
+}
+
+// SolutionPath mengembalikan path solution.go untuk e.
+func (w Workspace) SolutionPath(e Exercise) string {
+	return filepath.Join(w.TaskDir(e), SolutionFile)
+}
+
+// Start menulis stub e ke direktori kerja jika belum ada, lalu mengembalikan
+// path solution.go. Jawaban yang sudah ada tidak pernah ditimpa.
+func (w Workspace) Start(e Exercise) (string, error) {
+	dir := w.TaskDir(e)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	// go.mod agar editor dan `go vet` bisa memeriksa solution.go.
+	if err := writeNew(filepath.Join(dir, "go.mod"), []byte(goMod)); err != nil {
+		return "", err
+	}
+	path := w.SolutionPath(e)
+	if err := writeNew(path, e.Stub()); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// writeNew menulis data ke path hanya jika file belum ada.
+func writeNew(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if errors.Is(err, fs.ErrExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Result adalah hasil menjalankan test tersembunyi.
+type Result struct {
+	Passed bool
+	Output string // keluaran `go test`
+}
+
+// Check menyalin file .go dari direktori latihan e ke direktori sementara
+// bersama test tersembunyi, lalu menjalankan `go test`. Test yang gagal
+// bukan error; error hanya dikembalikan jika test tidak bisa dijalankan.
+func (w Workspace) Check(ctx context.Context, e Exercise) (Result, error) {
+	if _, err := os.Stat(w.SolutionPath(e)); errors.Is(err, fs.ErrNotExist) {
+		return Result{}, ErrNotStarted
+	}
+	return runHidden(ctx, e, w.TaskDir(e))
+}
+
+// runHidden menjalankan test tersembunyi e terhadap file .go di src.
+func runHidden(ctx context.Context, e Exercise, src string) (Result, error) {
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		return Result{}, ErrNoGo
+	}
+
+	tmp, err := os.MkdirTemp("", "learn-go-check-")
+	if err != nil {
+		return Result{}, err
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := copySources(src, tmp); err != nil {
+		return Result{}, err
+	}
+	if err := os.WriteFile(filepath.Join(tmp, "go.mod"), []byte(goMod), 0o644); err != nil {
+		return Result{}, err
+	}
+	if err := os.WriteFile(filepath.Join(tmp, hiddenTestFile), e.hiddenTest(), 0o644); err != nil {
+		return Result{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, CheckTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, gobin, "test", "-count=1", "-timeout=60s", ".")
+	cmd.Dir = tmp
+	cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=", "GO111MODULE=on")
+	var out bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &out, &out
+
+	err = cmd.Run()
+	if ctx.Err() != nil {
+		return Result{}, fmt.Errorf("go test: %w", ctx.Err())
+	}
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return Result{}, fmt.Errorf("go test: %w", err)
+	}
+	return Result{Passed: err == nil, Output: out.String()}, nil
+}
+
+// copySources menyalin file .go non-test dari src ke dst. Test milik
+// pengguna tidak ikut disalin agar tidak bentrok dengan test tersembunyi.
+func copySources(src, dst string) error {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(src, name))
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dst, name), data, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"learn-go/demo"
+	"learn-go/exercise"
+)
+
+// practice menyimpan direktori kerja dan progres latihan. Progres adalah
+// bagian dari riwayat belajar dan disimpan setiap kali berubah.
+type practice struct {
+	ws       exercise.Workspace
+	progress *exercise.Progress
+}
+
+func (a *app) openPractice(dir string) (*practice, error) {
+	if dir == "" {
+		var err error
+		if dir, err = exercise.DefaultDir(); err != nil {
+			return nil, err
+		}
+	}
+	h, err := a.loadHistory()
+	if err != nil {
+		return nil, err
+	}
+	return &practice{ws: exercise.Workspace{Dir: dir}, progress: &h.Progress}, nil
+}
+
+func (a *app) runExercise(args []string) int {
+	fs := a.newFlagSet("exercise", func(fs *flag.FlagSet) {
+		fmt.Fprint(fs.Output(), a.msg.T("cli.exercise.usage"))
+		fs.PrintDefaults()
+	})
+	dir := fs.String("dir", "", a.msg.T("cli.flag.exercise_dir"))
+	if code, ok := a.parse(fs, args); !ok {
+		return code
+	}
+
+	p, err := a.openPractice(*dir)
+	if err != nil {
+		fmt.Fprintf(a.stderr, "learn-go exercise: %v\n", err)
+		return exitFail
+	}
+
+	action := "list"
+	if fs.NArg() > 0 {
+		action = fs.Arg(0)
+	}
+	if action == "list" {
+		a.printExercises(a.stdout, p)
+		return exitOK
+	}
+	if fs.NArg() != 2 || (action != "start" && action != "hint" && action != "check") {
+		fs.Usage()
+		return exitUsage
+	}
+	e, ok := exercise.Lookup(fs.Arg(1))
+	if !ok {
+		fmt.Fprint(a.stderr, a.msg.Sprintf("cli.exercise.unknown", fs.Arg(1)))
+		return exitUsage
+	}
+
+	switch action {
+	case "start":
+		err = a.startExercise(a.stdout, p, e)
+	case "hint":
+		err = a.showHint(a.stdout, p, e)
+	case "check":
+		var passed bool
+		passed, err = a.checkExercise(a.stdout, p, e)
+		if err == nil && !passed {
+			return exitFail
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(a.stderr, "learn-go exercise: %v\n", err)
+		return exitFail
+	}
+	return exitOK
+}
+
+// printExercises menampilkan semua latihan per kategori beserta status dan
+// skornya.
+func (a *app) printExercises(w io.Writer, p *practice) {
+	l := a.msg.Locale()
+	category := ""
+	for i, e := range exercise.All() {
+		if e.Category() != category {
+			category = e.Category()
+			d, _ := demo.Lookup(category)
+			fmt.Fprintf(w, "\n%s\n", d.Title.In(l))
+		}
+		status := " "
+		score := fmt.Sprintf("-/%d", e.MaxPoints())
+		if r, ok := p.progress.Exercises[e.ID]; ok && r.Passed {
+			status = "✓"
+			score = fmt.Sprintf("%d/%d", r.Score, e.MaxPoints())
+		}
+		fmt.Fprintf(w, "  %2d. [%s] %-28s %-7s %s\n", i+1, status, e.ID, score, e.Title.In(l))
+	}
+	total, maxTotal := p.progress.Total()
+	fmt.Fprintln(w)
+	fmt.Fprint(w, a.msg.Sprintf("exercise.total", total, maxTotal, p.ws.Dir))
+}
+
+// startExercise menyiapkan solution.go lalu menampilkan soal latihan e.
+func (a *app) startExercise(w io.Writer, p *practice, e exercise.Exercise) error {
+	path, err := p.ws.Start(e)
+	if err != nil {
+		return err
+	}
+	l := a.msg.Locale()
+	fmt.Fprintf(w, "=== %s ===\n", strings.ToUpper(e.Title.In(l)))
+	fmt.Fprintln(w, e.Prompt.In(l))
+	fmt.Fprintln(w)
+	fmt.Fprint(w, a.msg.Sprintf("exercise.edit_file", path))
+	fmt.Fprint(w, a.msg.Sprintf("exercise.how_to_check", e.ID))
+	return nil
+}
+
+// showHint membuka petunjuk berikutnya untuk e. Setiap petunjuk baru
+// mengurangi skor maksimal latihan.
+func (a *app) showHint(w io.Writer, p *practice, e exercise.Exercise) error {
+	n, fresh := p.progress.NextHint(e)
+	if n == 0 {
+		fmt.Fprintln(w, a.msg.T("exercise.no_hints"))
+		return nil
+	}
+	fmt.Fprint(w, a.msg.Sprintf("exercise.hint", n, len(e.Hints), e.Hints[n-1].In(a.msg.Locale())))
+	if !fresh {
+		fmt.Fprintln(w, a.msg.T("exercise.hints_exhausted"))
+		return nil
+	}
+	fmt.Fprint(w, a.msg.Sprintf("exercise.hint_penalty", exercise.HintPenalty))
+	return a.saveHistory()
+}
+
+// checkExercise menjalankan test tersembunyi untuk e dan mencatat hasilnya.
+func (a *app) checkExercise(w io.Writer, p *practice, e exercise.Exercise) (bool, error) {
+	fmt.Fprintln(w, a.msg.T("exercise.checking"))
+	res, err := p.ws.Check(context.Background(), e)
+	if errors.Is(err, exercise.ErrNotStarted) {
+		fmt.Fprint(w, a.msg.Sprintf("exercise.not_started", e.ID))
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	p.progress.RecordResult(e, res, a.clock.Now())
+	if err := a.saveHistory(); err != nil {
+		return false, err
+	}
+	if !res.Passed {
+		fmt.Fprintln(w, res.Output)
+		fmt.Fprintln(w, a.msg.T("exercise.failed"))
+		return false, nil
+	}
+	r := p.progress.Exercises[e.ID]
+	fmt.Fprint(w, a.msg.Sprintf("exercise.passed", r.Score, e.MaxPoints()))
+	return true, nil
+}
+
+// ========== MENU LATIHAN ==========
+
+// exerciseMenu menampilkan daftar latihan di menu interaktif. Kembali ke
+// menu utama dengan memilih 0.
+func (a *app) exerciseMenu(reader *bufio.Reader) {
+	p, err := a.openPractice("")
+	if err != nil {
+		fmt.Fprintln(a.stdout, err)
+		return
+	}
+	all := exercise.All()
+	for {
+		a.printExercises(a.stdout, p)
+		fmt.Fprintf(a.stdout, "0. %s\n\n", a.msg.T("menu.back"))
+
+		choice, ok := a.readChoice(reader, len(all))
+		if !ok || choice == 0 {
+			return
+		}
+		fmt.Fprintln(a.stdout)
+		if !a.exerciseTaskMenu(reader, p, all[choice-1]) {
+			return
+		}
+	}
+}
+
+// exerciseTaskMenu menjalankan aksi untuk satu latihan. Nilai false berarti
+// input sudah habis.
+func (a *app) exerciseTaskMenu(reader *bufio.Reader, p *practice, e exercise.Exercise) bool {
+	if err := a.startExercise(a.stdout, p, e); err != nil {
+		fmt.Fprintln(a.stdout, err)
+		return true
+	}
+	for {
+		fmt.Fprintln(a.stdout)
+		fmt.Fprintf(a.stdout, "1. %s\n2. %s\n0. %s\n\n", a.msg.T("exercise.action.check"), a.msg.T("exercise.action.hint"), a.msg.T("menu.back"))
+		choice, ok := a.readChoice(reader, 2)
+		if !ok {
+			return false
+		}
+		fmt.Fprintln(a.stdout)
+		var err error
+		switch choice {
+		case 0:
+			return true
+		case 1:
+			_, err = a.checkExercise(a.stdout, p, e)
+		case 2:
+			err = a.showHint(a.stdout, p, e)
+		}
+		if err != nil {
+			fmt.Fprintln(a.stdout, err)
+		}
+	}
+}
+
+// readChoice membaca angka 0 sampai last dari reader, mengulang jika input
+// tidak valid. ok false jika input habis.
+func (a *app) readChoice(reader *bufio.Reader, last int) (choice int, ok bool) {
+	for {
+		fmt.Fprint(a.stdout, a.msg.Sprintf("menu.choose", last))
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			return 0, false
+		}
+		choice, err := strconv.Atoi(strings.TrimSpace(input))
+		if err != nil || choice < 0 || choice > last {
+			fmt.Fprintln(a.stdout, a.msg.Sprintf("menu.invalid_choice", last))
+			continue
+		}
+		return choice, true
+	}
+}
@@ -0,0 +1,130 @@
+package fileproc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Encoding adalah encoding teks yang dideteksi dari BOM (byte order mark).
+type Encoding string
+
+const (
+	UTF8    Encoding = "UTF-8"     // tanpa BOM
+	UTF8BOM Encoding = "UTF-8 BOM" // diawali EF BB BF
+	UTF16LE Encoding = "UTF-16LE"  // diawali FF FE
+	UTF16BE Encoding = "UTF-16BE"  // diawali FE FF
+)
+
+// ErrOddLength dilaporkan untuk input UTF-16 dengan jumlah byte ganjil.
+var ErrOddLength = errors.New("UTF-16 dengan jumlah byte ganjil")
+
+var boms = []struct {
+	bom []byte
+	enc Encoding
+}{
+	{[]byte{0xEF, 0xBB, 0xBF}, UTF8BOM},
+	{[]byte{0xFF, 0xFE}, UTF16LE},
+	{[]byte{0xFE, 0xFF}, UTF16BE},
+}
+
+// decode membaca BOM di awal r, membuangnya, dan mengembalikan reader yang
+// menghasilkan teks UTF-8. Input tanpa BOM dianggap UTF-8.
+func decode(r io.Reader) (io.Reader, Encoding, error) {
+	br := bufio.NewReader(r)
+	head, err := br.Peek(3)
+	if err != nil && err != io.EOF {
+		return nil, "", err
+	}
+	for _, b := range boms {
+		if !bytes.HasPrefix(head, b.bom) {
+			continue
+		}
+		br.Discard(len(b.bom))
+		switch b.enc {
+		case UTF16LE:
+			return &utf16Reader{r: br, order: binary.LittleEndian}, b.enc, nil
+		case UTF16BE:
+			return &utf16Reader{r: br, order: binary.BigEndian}, b.enc, nil
+		}
+		return br, b.enc, nil
+	}
+	return br, UTF8, nil
+}
+
+// utf16Reader mengubah aliran UTF-16 menjadi UTF-8. Surrogate yang tidak
+// berpasangan, termasuk high surrogate di akhir input, diganti dengan
+// U+FFFD tanpa menelan code unit sesudahnya.
+type utf16Reader struct {
+	r       *bufio.Reader
+	order   binary.ByteOrder
+	out     []byte // hasil dekode yang belum dibaca
+	err     error
+	pending []uint16 // code unit yang sudah dibaca tetapi belum didekode
+}
+
+func (u *utf16Reader) Read(p []byte) (int, error) {
+	for len(u.out) == 0 && u.err == nil {
+		u.fill(len(p))
+	}
+	if len(u.out) == 0 {
+		return 0, u.err
+	}
+	n := copy(p, u.out)
+	u.out = u.out[n:]
+	return n, nil
+}
+
+// fill mendekode kira-kira want byte UTF-8 ke u.out.
+func (u *utf16Reader) fill(want int) {
+	for len(u.out) < want && u.err == nil {
+		r1, err := u.unit()
+		if err != nil {
+			u.err = err
+			return
+		}
+		r := rune(r1)
+		if isHighSurrogate(r1) {
+			r2, err := u.unit()
+			switch {
+			case err != nil:
+				// High surrogate di akhir input: tulis U+FFFD lalu
+				// laporkan error pada panggilan berikutnya.
+				r, u.err = utf8.RuneError, err
+			case isLowSurrogate(r2):
+				r = utf16.DecodeRune(r, rune(r2))
+			default:
+				r = utf8.RuneError
+				u.pending = append(u.pending, r2)
+			}
+		} else if utf16.IsSurrogate(r) {
+			r = utf8.RuneError // low surrogate tanpa pasangan
+		}
+		u.out = utf8.AppendRune(u.out, r)
+	}
+}
+
+func isHighSurrogate(c uint16) bool { return c >= 0xd800 && c < 0xdc00 }
+func isLowSurrogate(c uint16) bool  { return c >= 0xdc00 && c < 0xe000 }
+
+// unit membaca satu code unit 16-bit.
+func (u *utf16Reader) unit() (uint16, error) {
+	if len(u.pending) > 0 {
+		c := u.pending[0]
+		u.pending = u.pending[1:]
+		return c, nil
+	}
+	var buf [2]byte
+	n, err := io.ReadFull(u.r, buf[:])
+	switch {
+	case n == 1:
+		return 0, ErrOddLength
+	case err != nil:
+		return 0, err
+	}
+	return u.order.Uint16(buf[:]), nil
+}
@@ -0,0 +1,275 @@
+// Package fileproc memproses file teks baris demi baris: mendeteksi
+// kompresi gzip dan encoding dari BOM, menghitung baris, kata dan byte
+// seperti wc, dan melaporkan error beserta posisi file:baris.
+package fileproc
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"learn-go/cleanup"
+	"learn-go/textutil"
+)
+
+// MaxLineSize adalah panjang maksimal satu baris dalam byte setelah
+// didekode. Baris yang lebih panjang menghasilkan error ErrLineTooLong.
+const MaxLineSize = 1 << 20
+
+var (
+	// ErrInvalidUTF8 dilaporkan sebagai peringatan di Counts.Warnings untuk
+	// baris yang bukan UTF-8 valid.
+	ErrInvalidUTF8 = errors.New("bukan UTF-8 yang valid")
+	// ErrLineTooLong dilaporkan untuk baris yang melebihi MaxLineSize.
+	ErrLineTooLong = errors.New("baris terlalu panjang")
+)
+
+// PosError adalah error pada posisi tertentu di sebuah file. Line dan
+// Column dimulai dari 1; nilai 0 berarti posisinya tidak diketahui atau
+// tidak relevan, misalnya saat file gagal dibuka.
+type PosError struct {
+	File   string
+	Line   int
+	Column int // byte ke-berapa di baris, 0 jika tidak relevan
+	Err    error
+}
+
+// Error memformat error seperti compiler: "file:baris:kolom: pesan".
+func (e *PosError) Error() string {
+	switch {
+	case e.Line == 0:
+		return fmt.Sprintf("%s: %v", e.File, e.Err)
+	case e.Column == 0:
+		return fmt.Sprintf("%s:%d: %v", e.File, e.Line, e.Err)
+	default:
+		return fmt.Sprintf("%s:%d:%d: %v", e.File, e.Line, e.Column, e.Err)
+	}
+}
+
+// Unwrap mengembalikan error penyebab.
+func (e *PosError) Unwrap() error { return e.Err }
+
+// Line adalah satu baris hasil Scan, tanpa akhiran "\n" atau "\r\n".
+type Line struct {
+	Number int
+	Text   string
+}
+
+// Counts adalah hasil penghitungan satu input.
+type Counts struct {
+	Lines int
+	Words int
+	// Bytes adalah ukuran input setelah didekompresi, termasuk BOM,
+	// sama seperti `zcat file | wc -c`. Jika Scan berhenti karena error,
+	// Bytes adalah jumlah byte yang sudah terbaca.
+	Bytes      int64
+	Encoding   Encoding
+	Compressed bool
+	// Freq adalah jumlah kemunculan setiap kata (huruf kecil), dihitung
+	// dengan textutil.CountWords. Hanya diisi jika Options.Freq.
+	Freq map[string]int
+	// Warnings berisi masalah yang tidak menghentikan Scan, yaitu baris
+	// yang bukan UTF-8 valid (ErrInvalidUTF8), urut sesuai nomor baris.
+	// Baris itu tetap dihitung.
+	Warnings []*PosError
+}
+
+// Add menjumlahkan c dan other, misalnya untuk baris total.
+func (c Counts) Add(other Counts) Counts {
+	c.Lines += other.Lines
+	c.Words += other.Words
+	c.Bytes += other.Bytes
+	c.Warnings = append(slices.Clip(c.Warnings), other.Warnings...)
+	freq := make(map[string]int, len(c.Freq)+len(other.Freq))
+	for _, m := range []map[string]int{c.Freq, other.Freq} {
+		for w, n := range m {
+			freq[w] += n
+		}
+	}
+	c.Freq = freq
+	return c
+}
+
+// HumanBytes mengembalikan Bytes dalam format yang mudah dibaca.
+func (c Counts) HumanBytes() string {
+	return textutil.BytesToHuman(c.Bytes)
+}
+
+// WordCount adalah satu kata beserta jumlah kemunculannya.
+type WordCount struct {
+	Word  string
+	Count int
+}
+
+// Top mengembalikan n kata yang paling sering muncul, urut dari yang
+// terbanyak lalu menurut abjad. n <= 0 menghasilkan slice kosong.
+func (c Counts) Top(n int) []WordCount {
+	if n <= 0 {
+		return nil
+	}
+	all := make([]WordCount, 0, len(c.Freq))
+	for w, count := range c.Freq {
+		all = append(all, WordCount{w, count})
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Count != all[j].Count {
+			return all[i].Count > all[j].Count
+		}
+		return all[i].Word < all[j].Word
+	})
+	return all[:min(n, len(all))]
+}
+
+// Options mengatur Scan. Nilai nol hanya menghitung baris, kata dan byte.
+type Options struct {
+	// Freq mengisi Counts.Freq. Ukuran map-nya sebanding dengan jumlah
+	// kata unik di input, jadi aktifkan hanya jika dibutuhkan, misalnya
+	// untuk Top.
+	Freq bool
+}
+
+// Scan membaca r baris demi baris dan memanggil fn untuk setiap baris,
+// berhenti jika fn mengembalikan error. Input gzip didekompresi dan input
+// UTF-16 diubah ke UTF-8 secara otomatis. name hanya dipakai untuk posisi
+// di PosError.
+//
+// Baris yang bukan UTF-8 valid tidak menghentikan Scan: byte yang rusak
+// diganti U+FFFD sebelum baris dihitung dan diberikan ke fn, dan posisinya
+// dicatat di Counts.Warnings.
+//
+// Baris terakhir tanpa "\n" tetap dihitung, berbeda dari wc yang hanya
+// menghitung karakter "\n".
+func Scan(name string, r io.Reader, opts Options, fn func(Line) error) (c Counts, err error) {
+	posErr := func(line, col int, err error) error {
+		var pe *PosError
+		if errors.As(err, &pe) {
+			return err
+		}
+		return &PosError{File: name, Line: line, Column: col, Err: err}
+	}
+
+	br := bufio.NewReader(r)
+	if magic, _ := br.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return c, posErr(0, 0, err)
+		}
+		defer zr.Close()
+		br = bufio.NewReader(zr)
+		c.Compressed = true
+	}
+
+	counter := &countingReader{r: br}
+	defer func() { c.Bytes = counter.n }()
+	text, enc, err := decode(counter)
+	if err != nil {
+		return c, posErr(0, 0, err)
+	}
+	c.Encoding = enc
+
+	sc := bufio.NewScanner(text)
+	sc.Buffer(make([]byte, 0, 64*1024), MaxLineSize)
+	for sc.Scan() {
+		c.Lines++
+		raw := bytes.TrimSuffix(sc.Bytes(), []byte("\r"))
+		line := Line{Number: c.Lines, Text: string(raw)}
+		if !utf8.Valid(raw) {
+			c.Warnings = append(c.Warnings, &PosError{File: name, Line: c.Lines, Column: invalidAt(raw), Err: ErrInvalidUTF8})
+			line.Text = strings.ToValidUTF8(line.Text, "\uFFFD")
+		}
+		if opts.Freq {
+			if c.Freq == nil {
+				c.Freq = make(map[string]int)
+			}
+			for w, n := range textutil.CountWords(line.Text) {
+				c.Freq[w] += n
+				c.Words += n
+			}
+		} else {
+			c.Words += countWords(line.Text)
+		}
+		if fn != nil {
+			if err := fn(line); err != nil {
+				return c, posErr(c.Lines, 0, err)
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			err = ErrLineTooLong
+		}
+		// Baris yang gagal dibaca adalah baris setelah baris terakhir
+		// yang berhasil.
+		return c, posErr(c.Lines+1, 0, err)
+	}
+	return c, nil
+}
+
+// Count seperti Scan tanpa callback per baris.
+func Count(name string, r io.Reader, opts Options) (Counts, error) {
+	return Scan(name, r, opts, nil)
+}
+
+// File membuka path lalu memprosesnya dengan Scan. Error saat menutup
+// file digabung ke error yang dikembalikan.
+func File(path string, opts Options, fn func(Line) error) (c Counts, err error) {
+	var stack cleanup.Stack
+	defer stack.CloseInto(&err)
+
+	f, err := os.Open(path)
+	if err != nil {
+		return c, err
+	}
+	stack.PushCloser(path, f)
+	return Scan(path, f, opts, fn)
+}
+
+// countWords menghitung kata di s seperti len(strings.Fields(s)), tanpa
+// membuat slice atau map.
+func countWords(s string) int {
+	n := 0
+	inWord := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			inWord = false
+		} else if !inWord {
+			inWord = true
+			n++
+		}
+	}
+	return n
+}
+
+// invalidAt mengembalikan kolom (mulai dari 1) byte pertama yang bukan
+// UTF-8 valid di line.
+func invalidAt(line []byte) int {
+	for i := 0; i < len(line); {
+		r, size := utf8.DecodeRune(line[i:])
+		if r == utf8.RuneError && size <= 1 {
+			return i + 1
+		}
+		i += size
+	}
+	return 0
+}
+
+// countingReader menghitung byte yang sudah dibaca dari r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,196 @@
+package fileproc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+func gzipped(t *testing.T, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte(s))
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func utf16le(s string) []byte {
+	b := []byte{0xFF, 0xFE}
+	for _, u := range utf16.Encode([]rune(s)) {
+		b = append(b, byte(u), byte(u>>8))
+	}
+	return b
+}
+
+func TestCount(t *testing.T) {
+	const text = "Halo dunia\r\nhalo Go 🚀\n\nakhir tanpa newline"
+	tests := []struct {
+		name       string
+		input      []byte
+		enc        Encoding
+		compressed bool
+	}{
+		{"utf8", []byte(text), UTF8, false},
+		{"bom", append([]byte{0xEF, 0xBB, 0xBF}, text...), UTF8BOM, false},
+		{"utf16", utf16le(text), UTF16LE, false},
+		{"gzip", gzipped(t, text), UTF8, true},
+		{"gzip utf16", gzipped(t, string(utf16le(text))), UTF16LE, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lines []string
+			c, err := Scan(tt.name, bytes.NewReader(tt.input), Options{Freq: true}, func(l Line) error {
+				lines = append(lines, l.Text)
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.Lines != 4 || c.Words != 8 || c.Encoding != tt.enc || c.Compressed != tt.compressed {
+				t.Errorf("Counts = %+v", c)
+			}
+			if c.Freq["halo"] != 2 || lines[0] != "Halo dunia" || lines[1] != "halo Go 🚀" {
+				t.Errorf("Freq = %v, baris = %q", c.Freq, lines)
+			}
+		})
+	}
+
+	c, _ := Count("bom", bytes.NewReader(tests[1].input), Options{Freq: true})
+	if want := int64(len(text) + 3); c.Bytes != want {
+		t.Errorf("Bytes = %d, want %d", c.Bytes, want)
+	}
+	if top := c.Top(2); len(top) != 2 || top[0] != (WordCount{"halo", 2}) || top[1].Word != "akhir" {
+		t.Errorf("Top(2) = %v", top)
+	}
+	if top := c.Top(-1); len(top) != 0 {
+		t.Errorf("Top(-1) = %v, want kosong", top)
+	}
+}
+
+func TestUTF16Surrogates(t *testing.T) {
+	// units membentuk input UTF-16LE dengan BOM dari code unit mentah,
+	// termasuk surrogate yang tidak bisa dibuat lewat utf16.Encode.
+	units := func(us ...uint16) []byte {
+		b := []byte{0xFF, 0xFE}
+		for _, u := range us {
+			b = append(b, byte(u), byte(u>>8))
+		}
+		return b
+	}
+	tests := []struct {
+		name  string
+		input []byte
+		want  string
+	}{
+		{"pasangan", units('a', 0xD83D, 0xDE80), "a🚀"},
+		{"low tanpa pasangan", units(0xDC00, 'a', 'b'), "\uFFFDab"},
+		{"high diikuti bukan low", units(0xD800, 'b', 'c'), "\uFFFDbc"},
+		{"high diikuti high", units(0xD800, 0xD83D, 0xDE80), "\uFFFD🚀"},
+		{"high di akhir input", units('x', 0xD800), "x\uFFFD"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			_, err := Scan("in", bytes.NewReader(tt.input), Options{}, func(l Line) error {
+				got = append(got, l.Text)
+				return nil
+			})
+			if err != nil || len(got) != 1 || got[0] != tt.want {
+				t.Errorf("baris = %q, %v; want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorPosition(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		want  string
+		err   error
+	}{
+		{"too long", []byte("ok\n" + strings.Repeat("x", MaxLineSize+1)), "in:2: baris terlalu panjang", ErrLineTooLong},
+		{"odd utf16", append(utf16le("a\n"), 'b'), "in:2: UTF-16 dengan jumlah byte ganjil", ErrOddLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Count("in", bytes.NewReader(tt.input), Options{})
+			var pe *PosError
+			if !errors.As(err, &pe) || !errors.Is(err, tt.err) || err.Error() != tt.want {
+				t.Errorf("Count = %v, want %q", err, tt.want)
+			}
+		})
+	}
+
+	stop := errors.New("berhenti")
+	_, err := Scan("in", strings.NewReader("a\nb\nc\n"), Options{}, func(l Line) error {
+		if l.Number == 2 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || err.Error() != "in:2: berhenti" {
+		t.Errorf("Scan = %v", err)
+	}
+}
+
+func TestInvalidUTF8(t *testing.T) {
+	var got []string
+	c, err := Scan("in", strings.NewReader("ok\nab\xffc de\n\xfe\xfe\nsatu dua\n"), Options{}, func(l Line) error {
+		got = append(got, l.Text)
+		return nil
+	})
+	if err != nil || c.Lines != 4 || c.Words != 6 {
+		t.Fatalf("Scan = %+v, %v; want 4 baris 6 kata tanpa error", c, err)
+	}
+	if want := []string{"ok", "ab\uFFFDc de", "\uFFFD", "satu dua"}; !slices.Equal(got, want) {
+		t.Errorf("baris = %q, want %q", got, want)
+	}
+	var msgs []string
+	for _, w := range c.Warnings {
+		if !errors.Is(w, ErrInvalidUTF8) {
+			t.Errorf("peringatan %v bukan ErrInvalidUTF8", w)
+		}
+		msgs = append(msgs, w.Error())
+	}
+	if want := []string{"in:2:3: bukan UTF-8 yang valid", "in:3:1: bukan UTF-8 yang valid"}; !slices.Equal(msgs, want) {
+		t.Errorf("Warnings = %q, want %q", msgs, want)
+	}
+}
+
+// TestCountWords memastikan penghitung tanpa map sama dengan
+// textutil.CountWords yang dipakai untuk Freq.
+func TestCountWords(t *testing.T) {
+	for _, s := range []string{"", "  ", "satu", " satu  dua\ttiga ", "a\u00a0b", "Go go GO"} {
+		c, _ := Count("in", strings.NewReader(s), Options{})
+		f, _ := Count("in", strings.NewReader(s), Options{Freq: true})
+		if c.Words != len(strings.Fields(s)) || c.Words != f.Words {
+			t.Errorf("%q: Words = %d, dengan Freq %d, want %d", s, c.Words, f.Words, len(strings.Fields(s)))
+		}
+	}
+}
+
+func TestFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.txt.gz")
+	if err := os.WriteFile(path, gzipped(t, "satu dua\ntiga\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := File(path, Options{}, nil)
+	if err != nil || c.Lines != 2 || c.Words != 3 || c.Bytes != 14 || !c.Compressed || c.Freq != nil {
+		t.Errorf("File = %+v, %v", c, err)
+	}
+
+	_, err = File(filepath.Join(t.TempDir(), "tidak-ada.txt"), Options{}, nil)
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("File tidak ada = %v", err)
+	}
+}
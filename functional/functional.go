@@ -0,0 +1,46 @@
+// Package functional berisi contoh fungsi sebagai first-class citizen:
+// higher-order function, closure dan function yang mengembalikan function.
+package functional
+
+// ========== FUNGSI SEBAGAI VARIABLE ==========
+
+// MathOperation menjalankan operation terhadap a dan b.
+func MathOperation(a, b int, operation func(int, int) int) int {
+	return operation(a, b)
+}
+
+// ========== CLOSURE ==========
+
+// Counter mengembalikan closure yang menaikkan hitungan setiap dipanggil.
+func Counter() func() int {
+	count := 0
+	return func() int {
+		count++
+		return count
+	}
+}
+
+// Multiplier mengembalikan closure yang mengalikan input dengan factor.
+func Multiplier(factor int) func(int) int {
+	return func(x int) int {
+		return x * factor
+	}
+}
+
+// CreateValidator mengembalikan fungsi yang mengecek apakah nilai berada
+// di rentang [min, max].
+func CreateValidator(min, max int) func(int) bool {
+	return func(value int) bool {
+		return value >= min && value <= max
+	}
+}
+
+// ApplyToSlice menerapkan fn ke setiap elemen numbers dan mengembalikan
+// slice baru.
+func ApplyToSlice(numbers []int, fn func(int) int) []int {
+	result := make([]int, len(numbers))
+	for i, num := range numbers {
+		result[i] = fn(num)
+	}
+	return result
+}
@@ -0,0 +1,151 @@
+// Package i18n menyediakan katalog pesan bilingual (Indonesia/Inggris)
+// dengan dukungan bentuk jamak. Setiap paket mendaftarkan pesannya sendiri
+// lewat Register, biasanya dari init().
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Locale adalah kode bahasa dua huruf.
+type Locale string
+
+const (
+	Indonesian Locale = "id"
+	English    Locale = "en"
+)
+
+// Default adalah locale yang dipakai jika tidak ada pilihan lain, dan
+// sekaligus fallback jika sebuah pesan belum diterjemahkan.
+const Default = Indonesian
+
+// Locales mengembalikan semua locale yang didukung.
+func Locales() []Locale {
+	return []Locale{Indonesian, English}
+}
+
+// Parse mengubah nama locale seperti "en", "id-ID" atau "en_US.UTF-8"
+// menjadi Locale.
+func Parse(s string) (Locale, error) {
+	lang := strings.ToLower(s)
+	if i := strings.IndexAny(lang, "_-."); i >= 0 {
+		lang = lang[:i]
+	}
+	for _, l := range Locales() {
+		if Locale(lang) == l {
+			return l, nil
+		}
+	}
+	return "", fmt.Errorf("locale tidak didukung %q (pilih id atau en)", s)
+}
+
+// FromEnv memilih locale dari LC_ALL, LC_MESSAGES atau LANG, dengan urutan
+// prioritas yang sama seperti gettext. Nilai yang tidak dikenal, termasuk
+// "C" dan "POSIX", menghasilkan Default.
+func FromEnv() Locale {
+	for _, key := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		v := os.Getenv(key)
+		if v == "" {
+			continue
+		}
+		if l, err := Parse(v); err == nil {
+			return l
+		}
+		return Default
+	}
+	return Default
+}
+
+var (
+	catalogMu sync.RWMutex
+	catalog   = make(map[Locale]map[string]string)
+)
+
+// Register menambahkan pesan untuk locale l. Pesan berupa format
+// fmt.Sprintf; pesan dengan bentuk jamak memisahkan bentuk tunggal dan
+// jamak dengan "|", misalnya "%d year|%d years", dan dibaca dengan
+// Printer.N. Register panic jika key
+// sudah terdaftar untuk locale yang sama.
+func Register(l Locale, messages map[string]string) {
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+
+	m := catalog[l]
+	if m == nil {
+		m = make(map[string]string)
+		catalog[l] = m
+	}
+	for key, msg := range messages {
+		if _, dup := m[key]; dup {
+			panic("i18n: Register dipanggil dua kali untuk " + string(l) + "/" + key)
+		}
+		m[key] = msg
+	}
+}
+
+// Keys mengembalikan semua key yang terdaftar untuk l, terurut.
+func Keys(l Locale) []string {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+
+	keys := make([]string, 0, len(catalog[l]))
+	for key := range catalog[l] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func lookup(l Locale, key string) string {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+
+	if msg, ok := catalog[l][key]; ok {
+		return msg
+	}
+	if msg, ok := catalog[Default][key]; ok {
+		return msg
+	}
+	return key
+}
+
+// Printer menerjemahkan pesan ke satu locale.
+type Printer struct {
+	locale Locale
+}
+
+// NewPrinter membuat Printer untuk l.
+func NewPrinter(l Locale) *Printer {
+	return &Printer{locale: l}
+}
+
+// Locale mengembalikan locale p.
+func (p *Printer) Locale() Locale {
+	return p.locale
+}
+
+// T mengembalikan format pesan untuk key. Jika key belum diterjemahkan,
+// pesan dari Default dipakai, lalu key itu sendiri. Pesan dikembalikan
+// apa adanya sehingga "|" di teks bantuan seperti "id|en" tidak terpotong.
+func (p *Printer) T(key string) string {
+	return lookup(p.locale, key)
+}
+
+// Sprintf memformat pesan key dengan args.
+func (p *Printer) Sprintf(key string, args ...any) string {
+	return fmt.Sprintf(p.T(key), args...)
+}
+
+// N mengembalikan format pesan key dalam bentuk tunggal jika n == 1 dan
+// bentuk jamak jika tidak.
+func (p *Printer) N(key string, n int) string {
+	one, other, ok := strings.Cut(lookup(p.locale, key), "|")
+	if ok && n != 1 {
+		return other
+	}
+	return one
+}
@@ -0,0 +1,97 @@
+package i18n
+
+import "testing"
+
+func init() {
+	Register(Indonesian, map[string]string{
+		"test.hello": "Halo, %s!",
+		"test.years": "%d tahun",
+		"test.only":  "hanya ada di id",
+	})
+	Register(English, map[string]string{
+		"test.hello": "Hello, %s!",
+		"test.years": "%d year|%d years",
+	})
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Locale
+		wantErr bool
+	}{
+		{"id", Indonesian, false},
+		{"en", English, false},
+		{"en_US.UTF-8", English, false},
+		{"id-ID", Indonesian, false},
+		{"EN", English, false},
+		{"fr_FR", "", true},
+		{"", "", true},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("Parse(%q) = %q, %v; want %q, err=%t", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	tests := []struct {
+		lcAll, lang string
+		want        Locale
+	}{
+		{"", "", Default},
+		{"", "en_GB.UTF-8", English},
+		{"id_ID", "en_US", Indonesian},
+		{"", "C", Default},
+	}
+	for _, tt := range tests {
+		t.Setenv("LC_ALL", tt.lcAll)
+		t.Setenv("LC_MESSAGES", "")
+		t.Setenv("LANG", tt.lang)
+		if got := FromEnv(); got != tt.want {
+			t.Errorf("FromEnv() dengan LC_ALL=%q LANG=%q = %q; want %q", tt.lcAll, tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestPrinter(t *testing.T) {
+	en, id := NewPrinter(English), NewPrinter(Indonesian)
+
+	if got := en.Sprintf("test.hello", "Go"); got != "Hello, Go!" {
+		t.Errorf("en Sprintf = %q", got)
+	}
+	if got := id.Sprintf("test.hello", "Go"); got != "Halo, Go!" {
+		t.Errorf("id Sprintf = %q", got)
+	}
+	if got := en.T("test.only"); got != "hanya ada di id" {
+		t.Errorf("fallback ke Default = %q", got)
+	}
+	if got := en.T("test.missing"); got != "test.missing" {
+		t.Errorf("key tidak dikenal = %q", got)
+	}
+	if got := en.T("test.years"); got != "%d year|%d years" {
+		t.Errorf("T memotong pesan di \"|\": %q", got)
+	}
+}
+
+func TestPlural(t *testing.T) {
+	en, id := NewPrinter(English), NewPrinter(Indonesian)
+
+	for _, tt := range []struct {
+		p    *Printer
+		n    int
+		want string
+	}{
+		{en, 1, "%d year"},
+		{en, 0, "%d years"},
+		{en, 2, "%d years"},
+		{id, 1, "%d tahun"},
+		{id, 5, "%d tahun"},
+	} {
+		if got := tt.p.N("test.years", tt.n); got != tt.want {
+			t.Errorf("%s N(%d) = %q; want %q", tt.p.Locale(), tt.n, got, tt.want)
+		}
+	}
+}
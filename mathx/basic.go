@@ -0,0 +1,42 @@
+// Package mathx berisi fungsi-fungsi matematika yang dipakai di seluruh
+// contoh: aritmatika dasar, fungsi rekursif, konversi suhu, geometri dan
+// utilitas bilangan.
+package mathx
+
+import "learn-go/apperr"
+
+// ErrDivisionByZero dikembalikan oleh Divide ketika pembagi bernilai nol.
+// Kodenya apperr.CodeValidation, sehingga errors.Is(err, apperr.ErrValidation)
+// juga bernilai true.
+var ErrDivisionByZero error = apperr.New(apperr.CodeValidation, "tidak bisa dibagi dengan nol")
+
+// ========== FUNGSI DASAR ==========
+
+// Add mengembalikan hasil penjumlahan a dan b.
+func Add(a, b int) int {
+	return a + b
+}
+
+// Divide membagi a dengan b dan mengembalikan ErrDivisionByZero jika b nol.
+func Divide(a, b float64) (float64, error) {
+	if b == 0 {
+		return 0, ErrDivisionByZero
+	}
+	return a / b, nil
+}
+
+// Calculate mengembalikan jumlah dan hasil kali a dan b (named return values).
+func Calculate(a, b int) (sum, product int) {
+	sum = a + b
+	product = a * b
+	return // naked return
+}
+
+// Sum menjumlahkan semua angka (variadic parameters).
+func Sum(numbers ...int) int {
+	total := 0
+	for _, num := range numbers {
+		total += num
+	}
+	return total
+}
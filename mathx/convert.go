@@ -0,0 +1,23 @@
+package mathx
+
+// ========== KONVERSI SUHU ==========
+
+// CelsiusToFahrenheit mengonversi suhu dari Celsius ke Fahrenheit.
+func CelsiusToFahrenheit(celsius float64) float64 {
+	return (celsius * 9 / 5) + 32
+}
+
+// FahrenheitToCelsius mengonversi suhu dari Fahrenheit ke Celsius.
+func FahrenheitToCelsius(fahrenheit float64) float64 {
+	return (fahrenheit - 32) * 5 / 9
+}
+
+// KelvinToCelsius mengonversi suhu dari Kelvin ke Celsius.
+func KelvinToCelsius(kelvin float64) float64 {
+	return kelvin - 273.15
+}
+
+// CelsiusToKelvin mengonversi suhu dari Celsius ke Kelvin.
+func CelsiusToKelvin(celsius float64) float64 {
+	return celsius + 273.15
+}
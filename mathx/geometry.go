@@ -0,0 +1,32 @@
+package mathx
+
+import "math"
+
+// ========== GEOMETRI ==========
+
+// Distance menghitung jarak antara titik (x1, y1) dan (x2, y2).
+func Distance(x1, y1, x2, y2 float64) float64 {
+	dx := x2 - x1
+	dy := y2 - y1
+	return math.Sqrt(dx*dx + dy*dy)
+}
+
+// CircleArea menghitung luas lingkaran.
+func CircleArea(radius float64) float64 {
+	return math.Pi * radius * radius
+}
+
+// SphereVolume menghitung volume bola.
+func SphereVolume(radius float64) float64 {
+	return (4.0 / 3.0) * math.Pi * radius * radius * radius
+}
+
+// RectangleArea menghitung luas persegi panjang.
+func RectangleArea(width, height float64) float64 {
+	return width * height
+}
+
+// CylinderVolume menghitung volume tabung.
+func CylinderVolume(radius, height float64) float64 {
+	return math.Pi * radius * radius * height
+}
@@ -0,0 +1,44 @@
+package mathx
+
+import "math"
+
+// ========== NUMBER UTILITIES ==========
+
+// IsPrime mengecek apakah n adalah bilangan prima.
+func IsPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+	if n == 2 {
+		return true
+	}
+	if n%2 == 0 {
+		return false
+	}
+
+	// i <= n/i, bukan i*i <= n, agar i*i tidak overflow untuk n mendekati
+	// math.MaxInt.
+	for i := 3; i <= n/i; i += 2 {
+		if n%i == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// GeneratePrimes mengembalikan semua bilangan prima sampai n.
+func GeneratePrimes(n int) []int {
+	var primes []int
+	for i := 2; i <= n; i++ {
+		if IsPrime(i) {
+			primes = append(primes, i)
+		}
+	}
+	return primes
+}
+
+// RoundToDecimal membulatkan num ke sejumlah places angka di belakang koma.
+func RoundToDecimal(num float64, places int) float64 {
+	multiplier := math.Pow(10, float64(places))
+	return math.Round(num*multiplier) / multiplier
+}
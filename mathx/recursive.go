@@ -0,0 +1,38 @@
+package mathx
+
+// ========== RECURSIVE FUNCTION ==========
+
+// Factorial menghitung n! secara rekursif.
+func Factorial(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	return n * Factorial(n-1)
+}
+
+// Fibonacci mengembalikan bilangan Fibonacci ke-n secara rekursif.
+func Fibonacci(n int) int {
+	if n <= 1 {
+		return n
+	}
+	return Fibonacci(n-1) + Fibonacci(n-2)
+}
+
+// Power menghitung base pangkat exp secara rekursif.
+func Power(base, exp int) int {
+	if exp == 0 {
+		return 1
+	}
+	if exp == 1 {
+		return base
+	}
+	return base * Power(base, exp-1)
+}
+
+// GCD menghitung greatest common divisor dengan algoritma Euclid.
+func GCD(a, b int) int {
+	if b == 0 {
+		return a
+	}
+	return GCD(b, a%b)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"learn-go/demo"
+)
+
+// printMenu menampilkan daftar demo dari registry. Setelah demo ada
+// "Jalankan Semua Contoh" dan "Latihan", lalu 0 untuk keluar.
+func (a *app) printMenu(demos []demo.Demo) {
+	fmt.Fprintln(a.stdout, a.msg.T("menu.header"))
+	fmt.Fprintln(a.stdout, a.msg.T("menu.prompt_category"))
+	fmt.Fprintln(a.stdout)
+	for i, d := range demos {
+		fmt.Fprintf(a.stdout, "%d. %s\n", i+1, d.Title.In(a.msg.Locale()))
+	}
+	fmt.Fprintf(a.stdout, "%d. %s\n", len(demos)+1, a.msg.T("menu.run_all"))
+	fmt.Fprintf(a.stdout, "%d. %s\n", len(demos)+2, a.msg.T("menu.exercises"))
+	fmt.Fprintf(a.stdout, "0. %s\n", a.msg.T("menu.exit"))
+	fmt.Fprintln(a.stdout)
+}
+
+// interactive menjalankan menu interaktif yang membaca pilihan dari stdin.
+func (a *app) interactive() {
+	demos := demo.All()
+	runAll := len(demos) + 1
+	practice := runAll + 1
+	out := demo.NewOutput(a.stdout, demo.FormatText)
+	out.SetLocale(a.msg.Locale())
+
+	a.printMenu(demos)
+	reader := bufio.NewReader(a.stdin)
+
+	for {
+		fmt.Fprint(a.stdout, a.msg.Sprintf("menu.choose", practice))
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			fmt.Fprintln(a.stdout, a.msg.Sprintf("menu.read_error", err))
+			return
+		}
+
+		input = strings.TrimSpace(input)
+		choice, err := strconv.Atoi(input)
+		if err != nil {
+			fmt.Fprintln(a.stdout, a.msg.Sprintf("menu.invalid_input", practice))
+			continue
+		}
+
+		fmt.Fprintln(a.stdout)
+
+		switch {
+		case choice == 0:
+			fmt.Fprintln(a.stdout, a.msg.T("menu.goodbye"))
+			return
+		case choice == runAll:
+			demo.RunAll(out)
+			a.recordRunAll()
+		case choice == practice:
+			a.exerciseMenu(reader)
+			fmt.Fprintln(a.stdout)
+			a.printMenu(demos)
+			continue
+		case choice >= 1 && choice <= len(demos):
+			demos[choice-1].Run(out)
+			a.recordRun(demos[choice-1].ID, 0)
+		default:
+			fmt.Fprintln(a.stdout, a.msg.Sprintf("menu.invalid_choice", practice))
+			continue
+		}
+
+		fmt.Fprintln(a.stdout, a.msg.T("menu.press_enter"))
+		reader.ReadString('\n')
+		fmt.Fprintln(a.stdout)
+		a.printMenu(demos)
+	}
+}
@@ -0,0 +1,287 @@
+package main
+
+import "learn-go/i18n"
+
+func init() {
+	i18n.Register(i18n.Indonesian, map[string]string{
+		"cli.usage": `Penggunaan:
+  learn-go [--lang id|en] <perintah>
+  learn-go                      tampilan terminal (TUI); menu teks jika
+                                bukan terminal
+  learn-go tui                  tampilan terminal layar penuh (Linux)
+  learn-go menu                 menu interaktif berbasis teks
+  learn-go demo <kategori>      jalankan contoh satu kategori
+  learn-go run all              jalankan semua contoh
+                                (--format json|jsonl untuk keluaran terstruktur)
+  learn-go util <nama> <arg>... panggil satu fungsi utilitas
+  learn-go wc [flag] [file]...  hitung baris, kata dan byte (gzip dan
+                                UTF-16 dikenali otomatis)
+  learn-go serve [--addr host:port]
+                                jalankan playground HTTP (JSON API + /openapi.json)
+  learn-go exercise [list|start|hint|check] [id]
+                                latihan dengan test tersembunyi dan skor
+  learn-go progress [show|reset|export]
+                                ringkasan progres belajar
+  learn-go help                 tampilkan bantuan ini
+
+Flag:
+`,
+		"cli.categories":      "Kategori:",
+		"cli.exit_codes":      "Exit code: 0 berhasil, 1 gagal atau validasi false, 2 penggunaan salah.\n",
+		"cli.unknown_command": "learn-go: perintah tidak dikenal %q\n\n",
+		"cli.write_failed":    "learn-go: gagal menulis keluaran: %v\n",
+		"cli.flag.lang":       "bahasa keluaran: id atau en (bawaan dari LC_ALL, LC_MESSAGES atau LANG)",
+		"cli.flag.format":     "format keluaran: text, json atau jsonl",
+		"cli.flag.section":    "hanya jalankan section bernomor ini (0 = semua)",
+		"cli.flag.seed":       "seed angka acak agar keluaran bisa diulang (0 = acak)",
+		"cli.demo.usage":      "Penggunaan: learn-go demo [flag] <kategori>",
+		"cli.demo.unknown":    "learn-go demo: kategori tidak dikenal %q\n",
+		"cli.run.usage":       "Penggunaan: learn-go run [flag] all",
+
+		"cli.tui.usage": "Penggunaan: learn-go tui",
+
+		"cli.serve.usage":           "Penggunaan: learn-go serve [flag]",
+		"cli.flag.addr":             "alamat yang didengarkan server",
+		"cli.serve.listening":       "Playground berjalan di http://%s (dokumen OpenAPI: http://%s/openapi.json, metrik: http://%s/metrics)\nTekan Ctrl+C untuk berhenti.\n",
+		"cli.serve.stopped":         "Server dihentikan.",
+		"cli.serve.help_requests":   "Jumlah request ke playground.",
+		"cli.serve.help_errors":     "Jumlah request dengan status 4xx atau 5xx.",
+		"cli.serve.help_in_flight":  "Jumlah request yang sedang diproses.",
+		"cli.serve.help_duration":   "Lama memproses request dalam detik.",
+		"cli.serve.help_goroutines": "Jumlah goroutine yang berjalan.",
+
+		"cli.exercise.usage": `Penggunaan: learn-go exercise [flag] [list|start|hint|check] [id]
+  list          daftar latihan, status dan skor (bawaan)
+  start <id>    siapkan solution.go dan tampilkan soal
+  hint <id>     buka petunjuk berikutnya (mengurangi skor)
+  check <id>    jalankan test tersembunyi; exit code 1 jika gagal
+`,
+		"cli.exercise.unknown":  "learn-go exercise: latihan tidak dikenal %q\n",
+		"cli.flag.exercise_dir": "direktori kerja latihan (bawaan $LEARN_GO_EXERCISES atau ~/learn-go-exercises)",
+
+		"exercise.total":           "Skor: %d/%d. Direktori kerja: %s\n",
+		"exercise.edit_file":       "Kerjakan di: %s\n",
+		"exercise.how_to_check":    "Periksa dengan: learn-go exercise check %s\n",
+		"exercise.no_hints":        "Latihan ini tidak punya petunjuk.",
+		"exercise.hint":            "Petunjuk %d/%d: %s\n",
+		"exercise.hints_exhausted": "Semua petunjuk sudah dibuka.",
+		"exercise.hint_penalty":    "Skor maksimal berkurang %d poin.\n",
+		"exercise.checking":        "Menjalankan test tersembunyi...",
+		"exercise.not_started":     "Latihan belum dimulai. Jalankan: learn-go exercise start %s\n",
+		"exercise.failed":          "Belum lulus. Perbaiki solution.go lalu periksa lagi.",
+		"exercise.passed":          "Lulus! Skor: %d/%d\n",
+		"exercise.action.check":    "Periksa jawaban",
+		"exercise.action.hint":     "Tampilkan petunjuk",
+
+		"cli.progress.usage": `Penggunaan: learn-go progress [show|reset|export] [flag]
+  show          tabel ringkasan per kategori (bawaan)
+  reset --yes   hapus semua riwayat dan progres latihan
+  export        tulis riwayat ke stdout (--format json|csv)
+`,
+		"cli.flag.export_format": "format ekspor: json atau csv",
+		"cli.flag.yes":           "konfirmasi reset tanpa bertanya",
+
+		"progress.table_header":   "KATEGORI\tSECTION\tRUN\tTERAKHIR\tLATIHAN\tSKOR",
+		"progress.total":          "Total",
+		"progress.file":           "File riwayat: %s\n",
+		"progress.reset_confirm":  "Ini akan menghapus %s. Ulangi dengan --yes untuk melanjutkan.\n",
+		"progress.reset_done":     "Riwayat belajar dihapus.",
+		"progress.unknown_format": "learn-go progress: format tidak dikenal %q (json atau csv)\n",
+		"progress.save_failed":    "learn-go: gagal menyimpan riwayat belajar: %v\n",
+
+		"cli.wc.usage": `Penggunaan: learn-go wc [flag] [file]...
+Tanpa file atau dengan "-", input dibaca dari stdin.
+`,
+		"cli.flag.human":     "tampilkan ukuran dalam KB, MB, dan seterusnya",
+		"cli.flag.top":       "tampilkan N kata yang paling sering muncul",
+		"cli.wc.invalid_top": "learn-go wc: --top tidak boleh negatif (%d)\n",
+		"cli.wc.warning":     "learn-go wc: peringatan: %v\n",
+		"cli.wc.more":        "learn-go wc: %s: %d peringatan lain tidak ditampilkan\n",
+		"wc.total":           "total",
+		"wc.top":             "Kata terbanyak:",
+
+		"cli.util.usage":         "Penggunaan: learn-go util <nama> <arg>...",
+		"cli.util.unknown":       "learn-go util: fungsi tidak dikenal %q\n",
+		"cli.util.command_usage": "Penggunaan: learn-go util %s %s\n",
+		"cli.util.invalid_arg":   "learn-go util %s: argumen tidak valid %q: %v\n",
+
+		"util.arg.nik":         "<nik>",
+		"util.arg.phone":       "<nomor>",
+		"util.arg.email":       "<email>",
+		"util.arg.zip_code":    "<kode-pos>",
+		"util.arg.number":      "<angka>",
+		"util.arg.bytes":       "<byte>",
+		"util.arg.year":        "<tahun>",
+		"util.arg.temperature": "<suhu>",
+		"util.arg.text":        "<teks>",
+
+		"util.help.nik":           "validasi NIK 16 digit",
+		"util.help.phone":         "validasi nomor telepon Indonesia",
+		"util.help.email":         "validasi email dengan regex",
+		"util.help.zip-code":      "validasi kode pos Indonesia (5 digit)",
+		"util.help.format-number": "format angka dengan pemisah ribuan",
+		"util.help.bytes":         "ubah jumlah byte menjadi format yang mudah dibaca",
+		"util.help.prime":         "cek bilangan prima",
+		"util.help.leap-year":     "cek tahun kabisat",
+		"util.help.celsius":       "konversi Celsius ke Fahrenheit",
+		"util.help.palindrome":    "cek palindrome",
+		"util.help.title-case":    "ubah teks menjadi Title Case",
+		"util.help.clean":         "rapikan spasi berlebih",
+		"util.help.reverse":       "balik teks",
+		"util.help.word-count":    "hitung kemunculan setiap kata",
+
+		"menu.header":          "=== LEARN GO - FUNGSI-FUNGSI GO ===",
+		"menu.prompt_category": "Pilih kategori fungsi yang ingin dipelajari:",
+		"menu.run_all":         "Jalankan Semua Contoh",
+		"menu.exercises":       "Latihan",
+		"menu.back":            "Kembali",
+		"menu.exit":            "Keluar",
+		"menu.choose":          "Masukkan pilihan (0-%d): ",
+		"menu.read_error":      "Gagal membaca input: %v",
+		"menu.invalid_input":   "Input tidak valid. Masukkan angka 0-%d.",
+		"menu.invalid_choice":  "Pilihan tidak valid. Masukkan angka 0-%d.",
+		"menu.goodbye":         "Terima kasih! Selamat belajar Go!",
+		"menu.press_enter":     "Tekan Enter untuk kembali ke menu...",
+	})
+
+	i18n.Register(i18n.English, map[string]string{
+		"cli.usage": `Usage:
+  learn-go [--lang id|en] <command>
+  learn-go                      terminal UI (TUI); text menu when not
+                                running in a terminal
+  learn-go tui                  full-screen terminal UI (Linux)
+  learn-go menu                 text-based interactive menu
+  learn-go demo <category>      run the examples of one category
+  learn-go run all              run every example
+                                (--format json|jsonl for structured output)
+  learn-go util <name> <arg>... call a single utility function
+  learn-go wc [flags] [file]... count lines, words and bytes (gzip and
+                                UTF-16 are detected automatically)
+  learn-go serve [--addr host:port]
+                                start the HTTP playground (JSON API + /openapi.json)
+  learn-go exercise [list|start|hint|check] [id]
+                                exercises with hidden tests and a score
+  learn-go progress [show|reset|export]
+                                learning progress summary
+  learn-go help                 show this help
+
+Flags:
+`,
+		"cli.categories":      "Categories:",
+		"cli.exit_codes":      "Exit code: 0 success, 1 failure or validation false, 2 usage error.\n",
+		"cli.unknown_command": "learn-go: unknown command %q\n\n",
+		"cli.write_failed":    "learn-go: failed to write output: %v\n",
+		"cli.flag.lang":       "output language: id or en (defaults from LC_ALL, LC_MESSAGES or LANG)",
+		"cli.flag.format":     "output format: text, json or jsonl",
+		"cli.flag.section":    "only run the section with this number (0 = all)",
+		"cli.flag.seed":       "random seed for repeatable output (0 = random)",
+		"cli.demo.usage":      "Usage: learn-go demo [flags] <category>",
+		"cli.demo.unknown":    "learn-go demo: unknown category %q\n",
+		"cli.run.usage":       "Usage: learn-go run [flags] all",
+
+		"cli.tui.usage": "Usage: learn-go tui",
+
+		"cli.serve.usage":           "Usage: learn-go serve [flags]",
+		"cli.flag.addr":             "address for the server to listen on",
+		"cli.serve.listening":       "Playground listening on http://%s (OpenAPI document: http://%s/openapi.json, metrics: http://%s/metrics)\nPress Ctrl+C to stop.\n",
+		"cli.serve.stopped":         "Server stopped.",
+		"cli.serve.help_requests":   "Number of playground requests.",
+		"cli.serve.help_errors":     "Number of requests with a 4xx or 5xx status.",
+		"cli.serve.help_in_flight":  "Number of requests being processed.",
+		"cli.serve.help_duration":   "Time taken to process a request, in seconds.",
+		"cli.serve.help_goroutines": "Number of running goroutines.",
+
+		"cli.exercise.usage": `Usage: learn-go exercise [flags] [list|start|hint|check] [id]
+  list          list exercises, status and score (default)
+  start <id>    create solution.go and show the task
+  hint <id>     reveal the next hint (lowers the score)
+  check <id>    run the hidden tests; exit code 1 on failure
+`,
+		"cli.exercise.unknown":  "learn-go exercise: unknown exercise %q\n",
+		"cli.flag.exercise_dir": "exercise workspace (default $LEARN_GO_EXERCISES or ~/learn-go-exercises)",
+
+		"exercise.total":           "Score: %d/%d. Workspace: %s\n",
+		"exercise.edit_file":       "Work in: %s\n",
+		"exercise.how_to_check":    "Check with: learn-go exercise check %s\n",
+		"exercise.no_hints":        "This exercise has no hints.",
+		"exercise.hint":            "Hint %d/%d: %s\n",
+		"exercise.hints_exhausted": "All hints have been revealed.",
+		"exercise.hint_penalty":    "Maximum score lowered by %d points.\n",
+		"exercise.checking":        "Running hidden tests...",
+		"exercise.not_started":     "Exercise not started yet. Run: learn-go exercise start %s\n",
+		"exercise.failed":          "Not passing yet. Fix solution.go and check again.",
+		"exercise.passed":          "Passed! Score: %d/%d\n",
+		"exercise.action.check":    "Check answer",
+		"exercise.action.hint":     "Show hint",
+
+		"cli.progress.usage": `Usage: learn-go progress [show|reset|export] [flags]
+  show          summary table per category (default)
+  reset --yes   delete all history and exercise progress
+  export        write the history to stdout (--format json|csv)
+`,
+		"cli.flag.export_format": "export format: json or csv",
+		"cli.flag.yes":           "confirm the reset without asking",
+
+		"progress.table_header":   "CATEGORY\tSECTIONS\tRUNS\tLAST RUN\tEXERCISES\tSCORE",
+		"progress.total":          "Total",
+		"progress.file":           "History file: %s\n",
+		"progress.reset_confirm":  "This will delete %s. Run again with --yes to continue.\n",
+		"progress.reset_done":     "Learning history deleted.",
+		"progress.unknown_format": "learn-go progress: unknown format %q (json or csv)\n",
+		"progress.save_failed":    "learn-go: failed to save learning history: %v\n",
+
+		"cli.wc.usage": `Usage: learn-go wc [flags] [file]...
+With no file, or when file is "-", read standard input.
+`,
+		"cli.flag.human":     "show sizes in KB, MB, and so on",
+		"cli.flag.top":       "show the N most frequent words",
+		"cli.wc.invalid_top": "learn-go wc: --top must not be negative (%d)\n",
+		"cli.wc.warning":     "learn-go wc: warning: %v\n",
+		"cli.wc.more":        "learn-go wc: %s: %d more warning not shown\n|learn-go wc: %s: %d more warnings not shown\n",
+		"wc.total":           "total",
+		"wc.top":             "Most frequent words:",
+
+		"cli.util.usage":         "Usage: learn-go util <name> <arg>...",
+		"cli.util.unknown":       "learn-go util: unknown function %q\n",
+		"cli.util.command_usage": "Usage: learn-go util %s %s\n",
+		"cli.util.invalid_arg":   "learn-go util %s: invalid argument %q: %v\n",
+
+		"util.arg.nik":         "<nik>",
+		"util.arg.phone":       "<phone>",
+		"util.arg.email":       "<email>",
+		"util.arg.zip_code":    "<zip-code>",
+		"util.arg.number":      "<number>",
+		"util.arg.bytes":       "<bytes>",
+		"util.arg.year":        "<year>",
+		"util.arg.temperature": "<celsius>",
+		"util.arg.text":        "<text>",
+
+		"util.help.nik":           "validate a 16-digit NIK",
+		"util.help.phone":         "validate an Indonesian phone number",
+		"util.help.email":         "validate an email address with a regex",
+		"util.help.zip-code":      "validate an Indonesian zip code (5 digits)",
+		"util.help.format-number": "format a number with thousands separators",
+		"util.help.bytes":         "turn a byte count into a human-readable size",
+		"util.help.prime":         "check whether a number is prime",
+		"util.help.leap-year":     "check whether a year is a leap year",
+		"util.help.celsius":       "convert Celsius to Fahrenheit",
+		"util.help.palindrome":    "check for a palindrome",
+		"util.help.title-case":    "convert text to Title Case",
+		"util.help.clean":         "collapse extra whitespace",
+		"util.help.reverse":       "reverse text",
+		"util.help.word-count":    "count how often each word appears",
+
+		"menu.header":          "=== LEARN GO - GO FUNCTIONS ===",
+		"menu.prompt_category": "Choose a category to learn:",
+		"menu.run_all":         "Run All Examples",
+		"menu.exercises":       "Exercises",
+		"menu.back":            "Back",
+		"menu.exit":            "Exit",
+		"menu.choose":          "Enter a choice (0-%d): ",
+		"menu.read_error":      "Error reading input: %v",
+		"menu.invalid_input":   "Invalid input. Enter a number 0-%d.",
+		"menu.invalid_choice":  "Invalid choice. Enter a number 0-%d.",
+		"menu.goodbye":         "Thank you! Happy learning Go!",
+		"menu.press_enter":     "Press Enter to return to the menu...",
+	})
+}
@@ -0,0 +1,195 @@
+// Package metrics berisi metrik yang aman dipakai dari banyak goroutine
+// tanpa mutex di jalur panas: Counter atomik, ShardedCounter yang membagi
+// penambahan ke beberapa shard, Gauge, dan Histogram dengan bucket tetap.
+// Metrik didaftarkan ke Registry yang bisa diambil snapshot-nya dan
+// diekspor dalam format teks Prometheus.
+package metrics
+
+import (
+	"math"
+	"math/bits"
+	"math/rand/v2"
+	"runtime"
+	"sort"
+	"sync/atomic"
+)
+
+// ========== COUNTER ==========
+
+// Counter adalah counter yang hanya bisa naik, memakai satu nilai atomik.
+// Zero value siap dipakai. Cocok untuk counter yang jarang diperebutkan;
+// untuk ratusan goroutine yang menambah bersamaan pakai ShardedCounter.
+type Counter struct {
+	n atomic.Uint64
+}
+
+// Inc menaikkan counter sebanyak satu.
+func (c *Counter) Inc() { c.n.Add(1) }
+
+// Add menaikkan counter sebanyak n.
+func (c *Counter) Add(n uint64) { c.n.Add(n) }
+
+// Value mengembalikan nilai counter saat ini.
+func (c *Counter) Value() uint64 { return c.n.Load() }
+
+// cacheLine adalah ukuran cache line yang umum. Setiap shard diberi
+// padding agar shard yang berbeda tidak berbagi cache line (false sharing).
+const cacheLine = 64
+
+type shard struct {
+	n atomic.Uint64
+	_ [cacheLine - 8]byte
+}
+
+// ShardedCounter membagi penambahan ke beberapa shard yang dipilih secara
+// acak, sehingga goroutine yang menambah bersamaan jarang menyentuh cache
+// line yang sama. Value menjumlahkan semua shard, jadi lebih mahal dari
+// Counter.Value. Buat dengan NewShardedCounter.
+type ShardedCounter struct {
+	shards []shard
+	mask   uint32
+}
+
+// NewShardedCounter membuat ShardedCounter dengan n shard, dibulatkan ke
+// atas menjadi pangkat dua. n <= 0 berarti 4 kali GOMAXPROCS.
+func NewShardedCounter(n int) *ShardedCounter {
+	if n <= 0 {
+		n = 4 * runtime.GOMAXPROCS(0)
+	}
+	n = 1 << bits.Len(uint(n-1))
+	return &ShardedCounter{shards: make([]shard, n), mask: uint32(n - 1)}
+}
+
+// Inc menaikkan counter sebanyak satu.
+func (c *ShardedCounter) Inc() { c.Add(1) }
+
+// Add menaikkan counter sebanyak n pada satu shard acak.
+func (c *ShardedCounter) Add(n uint64) {
+	c.shards[rand.Uint32()&c.mask].n.Add(n)
+}
+
+// Value mengembalikan jumlah semua shard. Penambahan yang terjadi selama
+// Value berjalan mungkin belum terhitung.
+func (c *ShardedCounter) Value() uint64 {
+	var sum uint64
+	for i := range c.shards {
+		sum += c.shards[i].n.Load()
+	}
+	return sum
+}
+
+// ========== GAUGE ==========
+
+// Gauge adalah nilai yang bisa naik dan turun, misalnya jumlah request yang
+// sedang berjalan. Zero value siap dipakai dan bernilai 0.
+type Gauge struct {
+	bits atomic.Uint64 // math.Float64bits dari nilainya
+}
+
+// Set mengganti nilai gauge.
+func (g *Gauge) Set(v float64) { g.bits.Store(math.Float64bits(v)) }
+
+// Add menambahkan delta, boleh negatif.
+func (g *Gauge) Add(delta float64) { addFloat(&g.bits, delta) }
+
+// Inc menambahkan 1.
+func (g *Gauge) Inc() { g.Add(1) }
+
+// Dec mengurangi 1.
+func (g *Gauge) Dec() { g.Add(-1) }
+
+// Value mengembalikan nilai gauge saat ini.
+func (g *Gauge) Value() float64 { return math.Float64frombits(g.bits.Load()) }
+
+// addFloat menambahkan delta ke float64 yang disimpan sebagai bit di p,
+// dengan compare-and-swap sampai berhasil.
+func addFloat(p *atomic.Uint64, delta float64) {
+	for {
+		old := p.Load()
+		if p.CompareAndSwap(old, math.Float64bits(math.Float64frombits(old)+delta)) {
+			return
+		}
+	}
+}
+
+// ========== HISTOGRAM ==========
+
+// DefBuckets adalah batas atas bucket bawaan untuk durasi dalam detik,
+// dari 5ms sampai 10s.
+var DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// ExponentialBuckets mengembalikan count batas bucket mulai dari start,
+// masing-masing factor kali sebelumnya. Panic jika start <= 0, factor <= 1
+// atau count < 1.
+func ExponentialBuckets(start, factor float64, count int) []float64 {
+	if start <= 0 || factor <= 1 || count < 1 {
+		panic("metrics: ExponentialBuckets butuh start > 0, factor > 1 dan count >= 1")
+	}
+	buckets := make([]float64, count)
+	for i := range buckets {
+		buckets[i] = start
+		start *= factor
+	}
+	return buckets
+}
+
+// Histogram menghitung sebaran nilai ke bucket dengan batas atas tetap,
+// ditambah jumlah dan total nilainya. Buat dengan NewHistogram.
+type Histogram struct {
+	upper  []float64
+	counts []atomic.Uint64 // satu per bucket, ditambah satu untuk +Inf
+	sum    atomic.Uint64   // math.Float64bits dari total nilai
+}
+
+// NewHistogram membuat Histogram dengan batas atas buckets, yang harus
+// naik tegas. nil berarti DefBuckets. Bucket +Inf selalu ditambahkan.
+func NewHistogram(buckets []float64) *Histogram {
+	if buckets == nil {
+		buckets = DefBuckets
+	}
+	for i := 1; i < len(buckets); i++ {
+		if buckets[i] <= buckets[i-1] {
+			panic("metrics: batas bucket histogram harus naik tegas")
+		}
+	}
+	return &Histogram{
+		upper:  append([]float64(nil), buckets...),
+		counts: make([]atomic.Uint64, len(buckets)+1),
+	}
+}
+
+// Observe mencatat satu nilai v ke bucket pertama yang batas atasnya >= v.
+func (h *Histogram) Observe(v float64) {
+	i := sort.SearchFloat64s(h.upper, v)
+	h.counts[i].Add(1)
+	addFloat(&h.sum, v)
+}
+
+// Bucket adalah jumlah kumulatif nilai yang <= UpperBound.
+type Bucket struct {
+	UpperBound float64
+	Count      uint64
+}
+
+// HistogramSnapshot adalah isi Histogram pada satu saat.
+type HistogramSnapshot struct {
+	Buckets []Bucket // kumulatif, yang terakhir berbatas +Inf
+	Count   uint64
+	Sum     float64
+}
+
+// Snapshot mengembalikan bucket kumulatif, jumlah dan total nilai. Count
+// selalu sama dengan bucket +Inf.
+func (h *Histogram) Snapshot() HistogramSnapshot {
+	s := HistogramSnapshot{Buckets: make([]Bucket, len(h.counts))}
+	for i := range h.counts {
+		s.Count += h.counts[i].Load()
+		upper := math.Inf(1)
+		if i < len(h.upper) {
+			upper = h.upper[i]
+		}
+		s.Buckets[i] = Bucket{UpperBound: upper, Count: s.Count}
+	}
+	s.Sum = math.Float64frombits(h.sum.Load())
+	return s
+}
@@ -0,0 +1,142 @@
+package metrics
+
+import (
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"learn-go/concurrency"
+)
+
+func TestCountersConcurrent(t *testing.T) {
+	var c Counter
+	s := NewShardedCounter(0)
+	var g Gauge
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				c.Inc()
+				s.Inc()
+				g.Add(0.5)
+			}
+		}()
+	}
+	wg.Wait()
+	if c.Value() != 50_000 || s.Value() != 50_000 || g.Value() != 25_000 {
+		t.Errorf("Counter = %d, ShardedCounter = %d, Gauge = %v", c.Value(), s.Value(), g.Value())
+	}
+	if n := len(NewShardedCounter(5).shards); n != 8 {
+		t.Errorf("shard = %d, want 8 (dibulatkan ke pangkat dua)", n)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	h := NewHistogram([]float64{1, 5, 10})
+	for _, v := range []float64{0.5, 1, 3, 7, 12, 20} {
+		h.Observe(v)
+	}
+	s := h.Snapshot()
+	want := []uint64{2, 3, 4, 6} // kumulatif: <=1, <=5, <=10, +Inf
+	for i, b := range s.Buckets {
+		if b.Count != want[i] {
+			t.Errorf("bucket %v = %d, want %d", b.UpperBound, b.Count, want[i])
+		}
+	}
+	if s.Count != 6 || s.Sum != 43.5 {
+		t.Errorf("Count = %d, Sum = %v", s.Count, s.Sum)
+	}
+}
+
+func TestWritePrometheus(t *testing.T) {
+	r := NewRegistry()
+	r.Counter("jobs_total", "Jumlah job.\nBaris kedua").Add(3)
+	r.Gauge("queue_depth", "Panjang antrean.").Set(-1.5)
+	h := r.Histogram("latency_seconds", "", []float64{0.1, 1})
+	h.Observe(0.05)
+	h.Observe(2)
+
+	rec := httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	want := `# HELP jobs_total Jumlah job.\nBaris kedua
+# TYPE jobs_total counter
+jobs_total 3
+# TYPE latency_seconds histogram
+latency_seconds_bucket{le="0.1"} 1
+latency_seconds_bucket{le="1"} 1
+latency_seconds_bucket{le="+Inf"} 2
+latency_seconds_sum 2.05
+latency_seconds_count 2
+# HELP queue_depth Panjang antrean.
+# TYPE queue_depth gauge
+queue_depth -1.5
+`
+	if got := rec.Body.String(); got != want {
+		t.Errorf("keluaran:\n%s\nwant:\n%s", got, want)
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("Content-Type = %q", ct)
+	}
+}
+
+func TestRegisterPanics(t *testing.T) {
+	r := NewRegistry()
+	r.Counter("a", "")
+	for _, name := range []string{"a", "1abc", "a-b"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Counter(%q) tidak panic", name)
+				}
+			}()
+			r.Counter(name, "")
+		}()
+	}
+}
+
+// ========== BENCHMARK ==========
+
+// Bandingkan dengan: go test -bench . -cpu 1,4,16 ./metrics
+// SafeCounter memakai satu mutex sehingga makin lambat saat makin banyak
+// goroutine berebut; ShardedCounter hampir tidak terpengaruh.
+
+func BenchmarkSafeCounter(b *testing.B) {
+	var c concurrency.SafeCounter
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c.Increment()
+		}
+	})
+}
+
+func BenchmarkCounter(b *testing.B) {
+	var c Counter
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c.Inc()
+		}
+	})
+}
+
+func BenchmarkShardedCounter(b *testing.B) {
+	c := NewShardedCounter(0)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c.Inc()
+		}
+	})
+}
+
+func BenchmarkHistogramObserve(b *testing.B) {
+	h := NewHistogram(nil)
+	b.RunParallel(func(pb *testing.PB) {
+		v := 0.0
+		for pb.Next() {
+			h.Observe(v)
+			v += 0.001
+		}
+	})
+}
@@ -0,0 +1,188 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Type adalah jenis metrik seperti di baris "# TYPE" Prometheus.
+type Type string
+
+const (
+	TypeCounter   Type = "counter"
+	TypeGauge     Type = "gauge"
+	TypeHistogram Type = "histogram"
+)
+
+var nameRe = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+
+type entry struct {
+	name  string
+	help  string
+	typ   Type
+	value func() float64 // counter dan gauge
+	hist  *Histogram
+}
+
+// Registry menyimpan metrik beserta nama dan keterangannya. Zero value
+// siap dipakai dan aman dipakai bersama oleh banyak goroutine.
+//
+// Nama yang tidak valid atau sudah terdaftar membuat method pendaftaran
+// panic, karena itu kesalahan program, bukan kondisi saat berjalan.
+type Registry struct {
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+// NewRegistry membuat Registry kosong.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+func (r *Registry) register(e entry) {
+	if !nameRe.MatchString(e.name) {
+		panic(fmt.Sprintf("metrics: nama metrik tidak valid %q", e.name))
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.entries[e.name]; ok {
+		panic(fmt.Sprintf("metrics: metrik %q sudah terdaftar", e.name))
+	}
+	if r.entries == nil {
+		r.entries = make(map[string]entry)
+	}
+	r.entries[e.name] = e
+}
+
+// Counter membuat dan mendaftarkan Counter.
+func (r *Registry) Counter(name, help string) *Counter {
+	c := &Counter{}
+	r.register(entry{name: name, help: help, typ: TypeCounter, value: func() float64 { return float64(c.Value()) }})
+	return c
+}
+
+// ShardedCounter membuat dan mendaftarkan ShardedCounter dengan shards
+// shard (lihat NewShardedCounter).
+func (r *Registry) ShardedCounter(name, help string, shards int) *ShardedCounter {
+	c := NewShardedCounter(shards)
+	r.register(entry{name: name, help: help, typ: TypeCounter, value: func() float64 { return float64(c.Value()) }})
+	return c
+}
+
+// Gauge membuat dan mendaftarkan Gauge.
+func (r *Registry) Gauge(name, help string) *Gauge {
+	g := &Gauge{}
+	r.register(entry{name: name, help: help, typ: TypeGauge, value: g.Value})
+	return g
+}
+
+// GaugeFunc mendaftarkan gauge yang nilainya dihitung fn setiap kali
+// snapshot diambil, misalnya runtime.NumGoroutine.
+func (r *Registry) GaugeFunc(name, help string, fn func() float64) {
+	r.register(entry{name: name, help: help, typ: TypeGauge, value: fn})
+}
+
+// Histogram membuat dan mendaftarkan Histogram dengan batas bucket buckets
+// (lihat NewHistogram).
+func (r *Registry) Histogram(name, help string, buckets []float64) *Histogram {
+	h := NewHistogram(buckets)
+	r.register(entry{name: name, help: help, typ: TypeHistogram, hist: h})
+	return h
+}
+
+// ========== SNAPSHOT DAN EKSPOR ==========
+
+// Family adalah nilai satu metrik pada saat snapshot. Value diisi untuk
+// counter dan gauge, Histogram untuk histogram.
+type Family struct {
+	Name      string
+	Help      string
+	Type      Type
+	Value     float64
+	Histogram *HistogramSnapshot
+}
+
+// Snapshot mengembalikan nilai semua metrik, urut menurut nama.
+func (r *Registry) Snapshot() []Family {
+	r.mu.Lock()
+	entries := make([]entry, 0, len(r.entries))
+	for _, e := range r.entries {
+		entries = append(entries, e)
+	}
+	r.mu.Unlock()
+	sort.Slice(entries, func(i, j int) bool { return entries[i].name < entries[j].name })
+
+	families := make([]Family, len(entries))
+	for i, e := range entries {
+		f := Family{Name: e.name, Help: e.help, Type: e.typ}
+		if e.hist != nil {
+			s := e.hist.Snapshot()
+			f.Histogram = &s
+		} else {
+			f.Value = e.value()
+		}
+		families[i] = f
+	}
+	return families
+}
+
+// WritePrometheus menulis snapshot dalam format teks Prometheus (versi
+// 0.0.4), misalnya:
+//
+//	# HELP http_requests_total Jumlah request.
+//	# TYPE http_requests_total counter
+//	http_requests_total 42
+func (r *Registry) WritePrometheus(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, f := range r.Snapshot() {
+		if f.Help != "" {
+			fmt.Fprintf(bw, "# HELP %s %s\n", f.Name, escapeHelp(f.Help))
+		}
+		fmt.Fprintf(bw, "# TYPE %s %s\n", f.Name, f.Type)
+		if f.Histogram == nil {
+			fmt.Fprintf(bw, "%s %s\n", f.Name, formatFloat(f.Value))
+			continue
+		}
+		for _, b := range f.Histogram.Buckets {
+			fmt.Fprintf(bw, "%s_bucket{le=%q} %d\n", f.Name, formatFloat(b.UpperBound), b.Count)
+		}
+		fmt.Fprintf(bw, "%s_sum %s\n", f.Name, formatFloat(f.Histogram.Sum))
+		fmt.Fprintf(bw, "%s_count %d\n", f.Name, f.Histogram.Count)
+	}
+	return bw.Flush()
+}
+
+// Handler mengembalikan http.Handler yang menulis WritePrometheus, untuk
+// dipasang di endpoint seperti /metrics.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		r.WritePrometheus(w)
+	})
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var helpEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
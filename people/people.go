@@ -0,0 +1,75 @@
+// Package people berisi struct Person, Address dan Employee untuk
+// mendemonstrasikan method, embedded struct dan validasi.
+package people
+
+import (
+	"fmt"
+
+	"learn-go/validate"
+)
+
+// ========== STRUCT DAN METHODS ==========
+
+// Person menyimpan data dasar seseorang.
+type Person struct {
+	Name  string
+	Age   int
+	Email string
+}
+
+// GetInfo adalah method dengan value receiver.
+func (p Person) GetInfo() string {
+	return fmt.Sprintf("Name: %s, Age: %d, Email: %s", p.Name, p.Age, p.Email)
+}
+
+// SetAge adalah method dengan pointer receiver. Umur di luar MinAge-MaxAge
+// ditolak dengan error validasi dan p tidak berubah.
+func (p *Person) SetAge(age int) error {
+	var v validate.Collector
+	checkAge(&v, age)
+	if err := v.Err(); err != nil {
+		return err
+	}
+	p.Age = age
+	return nil
+}
+
+// SetEmail mengganti email p. Email yang formatnya salah ditolak dengan
+// error validasi dan p tidak berubah.
+func (p *Person) SetEmail(email string) error {
+	var v validate.Collector
+	checkEmail(&v, email)
+	if err := v.Err(); err != nil {
+		return err
+	}
+	p.Email = email
+	return nil
+}
+
+// IsAdult mengecek apakah p sudah berumur 18 tahun atau lebih.
+func (p Person) IsAdult() bool {
+	return p.Age >= 18
+}
+
+// ========== EMBEDDED STRUCT ==========
+
+// Address menyimpan alamat.
+type Address struct {
+	Street  string
+	City    string
+	ZipCode string
+}
+
+// Employee menggabungkan Person dan Address sebagai embedded struct.
+type Employee struct {
+	Person   // embedded struct
+	Address  // embedded struct
+	Salary   float64
+	JobTitle string
+}
+
+// GetFullInfo mengembalikan ringkasan lengkap data karyawan.
+func (e Employee) GetFullInfo() string {
+	return fmt.Sprintf("%s works as %s, lives at %s, %s, earns $%.2f",
+		e.Name, e.JobTitle, e.Street, e.City, e.Salary)
+}
@@ -0,0 +1,62 @@
+package people
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"learn-go/apperr"
+)
+
+// fieldNames mengembalikan nama field yang dilanggar di err.
+func fieldNames(err error) []string {
+	var e *apperr.Error
+	if !errors.As(err, &e) {
+		return nil
+	}
+	var names []string
+	for _, f := range e.Fields {
+		names = append(names, f.Name)
+	}
+	return names
+}
+
+func TestEmployeeValidate(t *testing.T) {
+	valid := Employee{
+		Person:  Person{Name: "Budi", Age: 30, Email: "budi@example.com"},
+		Address: Address{Street: "Jl. Merdeka 1", City: "Bandung", ZipCode: "40111"},
+		Salary:  9_000_000,
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Validate = %v", err)
+	}
+
+	bad := valid
+	bad.Person = Person{Name: " ", Age: 151, Email: "budi@"}
+	bad.ZipCode = "04011"
+	bad.Salary = -1
+	err := bad.Validate()
+	if !errors.Is(err, apperr.ErrValidation) {
+		t.Fatalf("Validate = %v, want error validasi", err)
+	}
+	want := []string{"Person.Name", "Person.Age", "Person.Email", "Address.ZipCode", "Salary"}
+	if got := fieldNames(err); !slices.Equal(got, want) {
+		t.Errorf("field = %v, want %v", got, want)
+	}
+}
+
+func TestSetters(t *testing.T) {
+	p := Person{Name: "Ani", Age: 20, Email: "ani@example.com"}
+	if err := p.SetAge(-1); !slices.Equal(fieldNames(err), []string{"Age"}) {
+		t.Errorf("SetAge(-1) = %v", err)
+	}
+	if err := p.SetEmail("bukan email"); !slices.Equal(fieldNames(err), []string{"Email"}) {
+		t.Errorf("SetEmail = %v", err)
+	}
+	if p.Age != 20 || p.Email != "ani@example.com" {
+		t.Errorf("p berubah setelah setter gagal: %+v", p)
+	}
+	if err := p.SetAge(21); err != nil || p.Age != 21 {
+		t.Errorf("SetAge(21) = %v, Age = %d", err, p.Age)
+	}
+}
@@ -0,0 +1,52 @@
+package people
+
+import (
+	"strings"
+
+	"learn-go/validate"
+)
+
+// ========== VALIDASI ==========
+
+// Batas umur yang dianggap valid.
+const (
+	MinAge = 0
+	MaxAge = 150
+)
+
+// Validate memeriksa semua field p dan mengembalikan satu error berisi
+// semua pelanggaran (lihat validate.Collector), atau nil jika valid.
+func (p Person) Validate() error {
+	var v validate.Collector
+	v.Check(strings.TrimSpace(p.Name) != "", "Name", validate.RuleRequired, "nama wajib diisi")
+	checkAge(&v, p.Age)
+	checkEmail(&v, p.Email)
+	return v.Err()
+}
+
+// Validate memeriksa semua field a.
+func (a Address) Validate() error {
+	var v validate.Collector
+	v.Check(strings.TrimSpace(a.Street) != "", "Street", validate.RuleRequired, "jalan wajib diisi")
+	v.Check(strings.TrimSpace(a.City) != "", "City", validate.RuleRequired, "kota wajib diisi")
+	v.Check(validate.IsValidZipCode(a.ZipCode), "ZipCode", validate.RuleZipCode, "kode pos harus 5 digit dan tidak diawali 0")
+	return v.Err()
+}
+
+// Validate memeriksa semua field e, termasuk Person dan Address di
+// dalamnya dengan nama field seperti "Person.Age" dan "Address.ZipCode".
+func (e Employee) Validate() error {
+	var v validate.Collector
+	v.Merge("Person", e.Person.Validate())
+	v.Merge("Address", e.Address.Validate())
+	v.Check(e.Salary >= 0, "Salary", validate.RuleNonNegative, "gaji tidak boleh negatif")
+	return v.Err()
+}
+
+func checkAge(v *validate.Collector, age int) {
+	v.Check(age >= MinAge && age <= MaxAge, "Age", validate.RuleAgeRange, "umur harus antara 0 dan 150")
+}
+
+func checkEmail(v *validate.Collector, email string) {
+	v.Check(validate.IsValidEmailRegex(email), "Email", validate.RuleEmail, "format email tidak valid")
+}
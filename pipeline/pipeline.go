@@ -0,0 +1,233 @@
+// Package pipeline menyusun tahap-tahap pemrosesan data yang berjalan
+// bersamaan dan terhubung dengan channel: Source, Map, Filter, Batch,
+// FanOut, Merge dan Sink.
+//
+// Semua tahap milik satu Pipeline. Error pertama dari tahap mana pun
+// membatalkan context pipeline sehingga semua goroutine berhenti, dan error
+// itu dikembalikan oleh Sink. Setiap channel yang dikembalikan tahap harus
+// dibaca oleh tahap berikutnya, dan setiap pipeline harus diakhiri dengan Sink;
+// Sink menunggu semua goroutine tahap selesai sehingga tidak ada goroutine
+// yang bocor, termasuk ketika konsumen berhenti lebih awal.
+package pipeline
+
+import (
+	"context"
+	"sync"
+)
+
+// Pipeline menyimpan context bersama dan error pertama dari semua tahap.
+type Pipeline struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	errOnce sync.Once
+	err     error
+}
+
+// New membuat Pipeline yang berhenti ketika ctx batal.
+func New(ctx context.Context) *Pipeline {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Pipeline{ctx: ctx, cancel: cancel}
+}
+
+// Context mengembalikan context pipeline, yang batal setelah error pertama.
+func (p *Pipeline) Context() context.Context {
+	return p.ctx
+}
+
+// fail mencatat err jika belum ada error lalu menghentikan semua tahap.
+func (p *Pipeline) fail(err error) {
+	p.errOnce.Do(func() {
+		p.err = err
+		p.cancel()
+	})
+}
+
+// goStage menjalankan fn sebagai goroutine tahap. Error dari fn
+// menghentikan pipeline.
+func (p *Pipeline) goStage(fn func() error) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		if err := fn(); err != nil {
+			p.fail(err)
+		}
+	}()
+}
+
+// wait menunggu semua tahap lalu mengembalikan error pertama. Jika tidak ada
+// tahap yang gagal tetapi context induk batal, error context yang
+// dikembalikan.
+func (p *Pipeline) wait() error {
+	p.wg.Wait()
+	parentErr := p.ctx.Err()
+	p.fail(nil) // error yang tercatat tidak bisa berubah lagi
+	if p.err != nil {
+		return p.err
+	}
+	return parentErr
+}
+
+// send mengirim v ke out kecuali pipeline berhenti lebih dulu.
+func send[T any](ctx context.Context, out chan<- T, v T) bool {
+	select {
+	case out <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// ========== TAHAP ==========
+
+// Source mengirim items satu per satu.
+func Source[T any](p *Pipeline, items ...T) <-chan T {
+	out := make(chan T)
+	p.goStage(func() error {
+		defer close(out)
+		for _, v := range items {
+			if !send(p.ctx, out, v) {
+				return nil
+			}
+		}
+		return nil
+	})
+	return out
+}
+
+// Map mengirim fn(v) untuk setiap v dari in. Error dari fn menghentikan
+// pipeline.
+func Map[In, Out any](p *Pipeline, in <-chan In, fn func(ctx context.Context, v In) (Out, error)) <-chan Out {
+	out := make(chan Out)
+	p.goStage(func() error {
+		defer close(out)
+		for v := range recv(p.ctx, in) {
+			r, err := fn(p.ctx, v)
+			if err != nil {
+				return err
+			}
+			if !send(p.ctx, out, r) {
+				return nil
+			}
+		}
+		return nil
+	})
+	return out
+}
+
+// Filter hanya meneruskan nilai yang membuat keep bernilai true.
+func Filter[T any](p *Pipeline, in <-chan T, keep func(v T) bool) <-chan T {
+	out := make(chan T)
+	p.goStage(func() error {
+		defer close(out)
+		for v := range recv(p.ctx, in) {
+			if keep(v) && !send(p.ctx, out, v) {
+				return nil
+			}
+		}
+		return nil
+	})
+	return out
+}
+
+// Batch mengelompokkan nilai dari in menjadi slice berisi size elemen.
+// Batch terakhir boleh lebih pendek. size < 1 dianggap 1.
+func Batch[T any](p *Pipeline, in <-chan T, size int) <-chan []T {
+	size = max(size, 1)
+	out := make(chan []T)
+	p.goStage(func() error {
+		defer close(out)
+		batch := make([]T, 0, size)
+		for v := range recv(p.ctx, in) {
+			batch = append(batch, v)
+			if len(batch) == size {
+				if !send(p.ctx, out, batch) {
+					return nil
+				}
+				batch = make([]T, 0, size)
+			}
+		}
+		if len(batch) > 0 && p.ctx.Err() == nil {
+			send(p.ctx, out, batch)
+		}
+		return nil
+	})
+	return out
+}
+
+// FanOut membagi nilai dari in ke n channel. Setiap nilai dikirim ke satu
+// channel saja, yaitu channel yang pertama siap menerima, sehingga tahap
+// yang lambat tidak menahan tahap lain. n < 1 dianggap 1.
+func FanOut[T any](p *Pipeline, in <-chan T, n int) []<-chan T {
+	outs := make([]<-chan T, max(n, 1))
+	for i := range outs {
+		out := make(chan T)
+		outs[i] = out
+		p.goStage(func() error {
+			defer close(out)
+			for v := range recv(p.ctx, in) {
+				if !send(p.ctx, out, v) {
+					return nil
+				}
+			}
+			return nil
+		})
+	}
+	return outs
+}
+
+// Merge menggabungkan beberapa channel menjadi satu. Channel hasil ditutup
+// setelah semua input ditutup.
+func Merge[T any](p *Pipeline, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	for _, in := range ins {
+		wg.Add(1)
+		p.goStage(func() error {
+			defer wg.Done()
+			for v := range recv(p.ctx, in) {
+				if !send(p.ctx, out, v) {
+					return nil
+				}
+			}
+			return nil
+		})
+	}
+	p.goStage(func() error {
+		wg.Wait()
+		close(out)
+		return nil
+	})
+	return out
+}
+
+// Sink memanggil fn untuk setiap nilai dari in di goroutine pemanggil, lalu
+// menunggu semua tahap selesai dan mengembalikan error pertama. Error dari
+// fn menghentikan pipeline. Sink hanya dipanggil sekali per Pipeline.
+func Sink[T any](p *Pipeline, in <-chan T, fn func(ctx context.Context, v T) error) error {
+	for v := range recv(p.ctx, in) {
+		if err := fn(p.ctx, v); err != nil {
+			p.fail(err)
+			break
+		}
+	}
+	return p.wait()
+}
+
+// recv mengembalikan iterator nilai dari in yang berhenti ketika in
+// ditutup atau ctx batal.
+func recv[T any](ctx context.Context, in <-chan T) func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok || !yield(v) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+)
+
+// checkGoroutines gagal jika jumlah goroutine tidak kembali ke jumlah awal
+// setelah test selesai. Goroutine yang baru keluar diberi waktu sebentar.
+func checkGoroutines(t *testing.T) {
+	t.Helper()
+	before := runtime.NumGoroutine()
+	t.Cleanup(func() {
+		deadline := time.Now().Add(time.Second)
+		for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		if n := runtime.NumGoroutine(); n > before {
+			buf := make([]byte, 1<<16)
+			t.Errorf("goroutine bocor: %d sebelum, %d sesudah\n%s", before, n, buf[:runtime.Stack(buf, true)])
+		}
+	})
+}
+
+func square(_ context.Context, n int) (int, error) {
+	return n * n, nil
+}
+
+func TestPipeline(t *testing.T) {
+	checkGoroutines(t)
+
+	p := New(context.Background())
+	nums := Source(p, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	var squares []<-chan int
+	for _, in := range FanOut(p, nums, 3) {
+		squares = append(squares, Map(p, in, square))
+	}
+	even := Filter(p, Merge(p, squares...), func(n int) bool { return n%2 == 0 })
+	batches := Batch(p, even, 2)
+
+	var got []int
+	sizes := 0
+	err := Sink(p, batches, func(_ context.Context, b []int) error {
+		got = append(got, b...)
+		sizes = sizes*10 + len(b)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(got)
+	if want := []int{4, 16, 36, 64, 100}; !slices.Equal(got, want) {
+		t.Errorf("hasil = %v, want %v", got, want)
+	}
+	if sizes != 221 {
+		t.Errorf("ukuran batch = %d, want 2, 2, 1", sizes)
+	}
+}
+
+func TestFirstErrorStopsPipeline(t *testing.T) {
+	checkGoroutines(t)
+
+	errBoom := errors.New("boom")
+	p := New(context.Background())
+	nums := Source(p, makeRange(1000)...)
+	var outs []<-chan int
+	for _, in := range FanOut(p, nums, 4) {
+		outs = append(outs, Map(p, in, func(ctx context.Context, n int) (int, error) {
+			if n == 10 {
+				return 0, errBoom
+			}
+			return n, nil
+		}))
+	}
+
+	seen := 0
+	err := Sink(p, Merge(p, outs...), func(context.Context, int) error {
+		seen++
+		return nil
+	})
+	if !errors.Is(err, errBoom) {
+		t.Errorf("Sink = %v, want errBoom", err)
+	}
+	if seen >= 1000 {
+		t.Errorf("pipeline tidak berhenti setelah error: %d nilai", seen)
+	}
+	if p.Context().Err() == nil {
+		t.Error("context pipeline belum batal")
+	}
+}
+
+// TestSinkStopsEarly memastikan tahap yang masih mengirim berhenti saat
+// konsumen berhenti membaca.
+func TestSinkStopsEarly(t *testing.T) {
+	checkGoroutines(t)
+
+	errEnough := errors.New("cukup")
+	p := New(context.Background())
+	doubled := Map(p, Source(p, makeRange(1000)...), func(_ context.Context, n int) (int, error) {
+		return n * 2, nil
+	})
+	var got []int
+	err := Sink(p, doubled, func(_ context.Context, n int) error {
+		got = append(got, n)
+		if len(got) == 3 {
+			return errEnough
+		}
+		return nil
+	})
+	if !errors.Is(err, errEnough) || !slices.Equal(got, []int{0, 2, 4}) {
+		t.Errorf("Sink = %v, %v", got, err)
+	}
+}
+
+func TestParentCancel(t *testing.T) {
+	checkGoroutines(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := New(ctx)
+	slow := Map(p, Source(p, makeRange(100)...), func(ctx context.Context, n int) (int, error) {
+		if n == 5 {
+			cancel()
+		}
+		<-time.After(time.Millisecond)
+		return n, nil
+	})
+	err := Sink(p, Batch(p, slow, 10), func(context.Context, []int) error { return nil })
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Sink = %v, want context.Canceled", err)
+	}
+}
+
+func makeRange(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
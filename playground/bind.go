@@ -0,0 +1,188 @@
+package playground
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ========== DECODE DAN VALIDASI REQUEST ==========
+
+// Tag struct yang dibaca oleh bind dan OpenAPI:
+//
+//	json:"name"            nama field; tanpa ",omitempty" berarti wajib ada
+//	doc:"..."              deskripsi field di dokumen OpenAPI
+//	min:"0" max:"20"       batas nilai angka (inklusif)
+
+// bind men-decode body ke dst lalu memeriksa field wajib dan batas nilai.
+// Body yang rusak atau tidak sesuai schema menghasilkan Error 400; nilai di
+// luar batas menghasilkan Error 422.
+func bind(body []byte, dst any) error {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return newError(http.StatusBadRequest, "empty_body", "", "playground.error.empty_body")
+	}
+
+	var raw any
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return newError(http.StatusBadRequest, "invalid_json", "", "playground.error.invalid_json")
+	}
+	t := reflect.TypeOf(dst).Elem()
+	if err := checkRequired(t, raw, ""); err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(dst); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return newError(http.StatusBadRequest, "invalid_type", typeErr.Field, "playground.error.invalid_type", typeErr.Field, schemaType(typeErr.Type))
+		}
+		if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+			field, _ = strconv.Unquote(field)
+			return newError(http.StatusBadRequest, "unknown_field", field, "playground.error.unknown_field", field)
+		}
+		return newError(http.StatusBadRequest, "invalid_json", "", "playground.error.invalid_json")
+	}
+	return checkLimits(reflect.ValueOf(dst).Elem(), "")
+}
+
+// jsonField mengembalikan nama JSON field f dan apakah field itu wajib.
+func jsonField(f reflect.StructField) (name string, required bool) {
+	tag := f.Tag.Get("json")
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = f.Name
+	}
+	return name, !slices.Contains(strings.Split(opts, ","), "omitempty")
+}
+
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// checkRequired memeriksa bahwa setiap field wajib dari t ada di raw,
+// termasuk di dalam slice dan struct bersarang.
+func checkRequired(t reflect.Type, raw any, path string) error {
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := raw.(map[string]any)
+		if !ok && path == "" {
+			return newError(http.StatusBadRequest, "invalid_json", "", "playground.error.expected_object")
+		}
+		if !ok {
+			return newError(http.StatusBadRequest, "invalid_type", path, "playground.error.invalid_type", path, "object")
+		}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name, required := jsonField(f)
+			v, present := obj[name]
+			if !present || v == nil {
+				if required {
+					field := joinPath(path, name)
+					return newError(http.StatusBadRequest, "missing_field", field, "playground.error.missing_field", field)
+				}
+				continue
+			}
+			if err := checkRequired(f.Type, v, joinPath(path, name)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		items, ok := raw.([]any)
+		if !ok {
+			return nil // tipe yang salah dilaporkan oleh json.Decoder
+		}
+		for i, item := range items {
+			if err := checkRequired(t.Elem(), item, path+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkLimits memeriksa tag min dan max pada v.
+func checkLimits(v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name, _ := jsonField(f)
+			field := joinPath(path, name)
+			if err := checkField(f, v.Field(i), field); err != nil {
+				return err
+			}
+			if err := checkLimits(v.Field(i), field); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if err := checkLimits(v.Index(i), path+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkField(f reflect.StructField, v reflect.Value, field string) error {
+	lo, hasMin := tagNumber(f, "min")
+	hi, hasMax := tagNumber(f, "max")
+	below := func(a, b any) bool {
+		if v.Kind() == reflect.Float64 {
+			return a.(float64) < b.(float64)
+		}
+		return a.(int64) < b.(int64)
+	}
+	var n any
+	switch v.Kind() {
+	case reflect.Int, reflect.Int64:
+		n = v.Int()
+	case reflect.Float64:
+		n = v.Float()
+	default:
+		return nil
+	}
+	switch {
+	case hasMin && hasMax && (below(n, lo) || below(hi, n)):
+		return invalid(field, "out_of_range", "playground.error.between", field, lo, hi)
+	case hasMin && below(n, lo):
+		return invalid(field, "out_of_range", "playground.error.min", field, lo)
+	case hasMax && below(hi, n):
+		return invalid(field, "out_of_range", "playground.error.max", field, hi)
+	}
+	return nil
+}
+
+// tagNumber membaca tag angka name pada f sebagai int64 untuk field bilangan
+// bulat atau float64 untuk field pecahan.
+func tagNumber(f reflect.StructField, name string) (any, bool) {
+	s, ok := f.Tag.Lookup(name)
+	if !ok {
+		return nil, false
+	}
+	var (
+		n   any
+		err error
+	)
+	if f.Type.Kind() == reflect.Float64 {
+		n, err = strconv.ParseFloat(s, 64)
+	} else {
+		n, err = strconv.ParseInt(s, 10, 64)
+	}
+	if err != nil {
+		panic("playground: tag " + name + " tidak valid pada field " + f.Name)
+	}
+	return n, true
+}
@@ -0,0 +1,43 @@
+package playground
+
+import "learn-go/i18n"
+
+func init() {
+	i18n.Register(i18n.Indonesian, map[string]string{
+		"playground.error.empty_body":       "body request kosong; kirim objek JSON",
+		"playground.error.invalid_json":     "body request bukan JSON yang valid",
+		"playground.error.expected_object":  "body request harus berupa objek JSON",
+		"playground.error.read_body":        "gagal membaca body request",
+		"playground.error.body_too_large":   "body request lebih dari %d byte",
+		"playground.error.media_type":       "Content-Type harus application/json",
+		"playground.error.missing_field":    "field %q wajib diisi",
+		"playground.error.unknown_field":    "field %q tidak dikenal",
+		"playground.error.invalid_type":     "field %q harus bertipe %s",
+		"playground.error.between":          "field %q harus antara %v dan %v",
+		"playground.error.min":              "field %q minimal %v",
+		"playground.error.max":              "field %q maksimal %v",
+		"playground.error.empty_list":       "field %q tidak boleh kosong",
+		"playground.error.not_sorted":       "field %q harus terurut naik",
+		"playground.error.division_by_zero": "tidak bisa dibagi dengan nol",
+		"playground.error.internal":         "terjadi kesalahan internal",
+	})
+
+	i18n.Register(i18n.English, map[string]string{
+		"playground.error.empty_body":       "request body is empty; send a JSON object",
+		"playground.error.invalid_json":     "request body is not valid JSON",
+		"playground.error.expected_object":  "request body must be a JSON object",
+		"playground.error.read_body":        "failed to read request body",
+		"playground.error.body_too_large":   "request body is larger than %d bytes",
+		"playground.error.media_type":       "Content-Type must be application/json",
+		"playground.error.missing_field":    "field %q is required",
+		"playground.error.unknown_field":    "unknown field %q",
+		"playground.error.invalid_type":     "field %q must be of type %s",
+		"playground.error.between":          "field %q must be between %v and %v",
+		"playground.error.min":              "field %q must be at least %v",
+		"playground.error.max":              "field %q must be at most %v",
+		"playground.error.empty_list":       "field %q must not be empty",
+		"playground.error.not_sorted":       "field %q must be sorted in ascending order",
+		"playground.error.division_by_zero": "cannot divide by zero",
+		"playground.error.internal":         "internal error",
+	})
+}
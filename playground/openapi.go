@@ -0,0 +1,141 @@
+package playground
+
+import (
+	"reflect"
+	"strings"
+)
+
+// ========== DOKUMEN OPENAPI ==========
+
+// OpenAPI membuat dokumen OpenAPI 3.0 dari Routes. Schema request dan
+// response diambil dari tipe struct setiap route, termasuk field wajib dan
+// tag min dan max, sehingga dokumen selalu sama dengan validasi yang
+// dijalankan server.
+func OpenAPI() map[string]any {
+	paths := map[string]any{}
+	for _, rt := range Routes() {
+		paths[rt.Path] = map[string]any{
+			strings.ToLower(rt.Method): map[string]any{
+				"operationId": operationID(rt.Path),
+				"summary":     rt.Summary,
+				"tags":        []string{rt.Tag},
+				"requestBody": map[string]any{
+					"required": true,
+					"content":  jsonContent(schemaOf(rt.Request)),
+				},
+				"responses": map[string]any{
+					"200": map[string]any{
+						"description": "OK",
+						"content":     jsonContent(schemaOf(rt.Response)),
+					},
+					"400": errorResponse("Malformed JSON or body does not match the schema"),
+					"413": errorResponse("Body too large"),
+					"415": errorResponse("Content-Type is not application/json"),
+					"422": errorResponse("Value out of range or cannot be processed"),
+				},
+			},
+		}
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "learn-go playground",
+			"version": "1.0.0",
+			"description": "learn-go helper functions as a JSON API. " +
+				"Error messages follow the Accept-Language header (id or en).",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": map[string]any{
+				"Error": schemaOf(reflect.TypeFor[errorBody]()),
+			},
+		},
+	}
+}
+
+// operationID mengubah "/v1/text/count-words" menjadi "textCountWords".
+func operationID(path string) string {
+	parts := strings.FieldsFunc(strings.TrimPrefix(path, "/v1/"), func(r rune) bool {
+		return r == '/' || r == '-'
+	})
+	for i := 1; i < len(parts); i++ {
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+	return strings.Join(parts, "")
+}
+
+func jsonContent(schema map[string]any) map[string]any {
+	return map[string]any{"application/json": map[string]any{"schema": schema}}
+}
+
+func errorResponse(description string) map[string]any {
+	return map[string]any{
+		"description": description,
+		"content":     jsonContent(map[string]any{"$ref": "#/components/schemas/Error"}),
+	}
+}
+
+// schemaType mengembalikan nama tipe JSON Schema untuk t.
+func schemaType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int64:
+		return "integer"
+	case reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Slice:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+// schemaOf membuat JSON Schema untuk t.
+func schemaOf(t reflect.Type) map[string]any {
+	s := map[string]any{"type": schemaType(t)}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int64:
+		s["format"] = "int64"
+	case reflect.Float64:
+		s["format"] = "double"
+	case reflect.Slice:
+		s["items"] = schemaOf(t.Elem())
+	case reflect.Map:
+		s["additionalProperties"] = schemaOf(t.Elem())
+	case reflect.Struct:
+		props := map[string]any{}
+		var required []string
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name, req := jsonField(f)
+			props[name] = fieldSchema(f)
+			if req {
+				required = append(required, name)
+			}
+		}
+		s["properties"] = props
+		s["additionalProperties"] = false
+		if len(required) > 0 {
+			s["required"] = required
+		}
+	}
+	return s
+}
+
+func fieldSchema(f reflect.StructField) map[string]any {
+	s := schemaOf(f.Type)
+	if doc := f.Tag.Get("doc"); doc != "" {
+		s["description"] = doc
+	}
+	if n, ok := tagNumber(f, "min"); ok {
+		s["minimum"] = n
+	}
+	if n, ok := tagNumber(f, "max"); ok {
+		s["maximum"] = n
+	}
+	return s
+}
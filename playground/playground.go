@@ -0,0 +1,177 @@
+// Package playground menyediakan server HTTP lokal yang membuka fungsi-fungsi
+// helper (validasi, teks, matematika, bangun datar) sebagai JSON API, beserta
+// dokumen OpenAPI yang dibuat dari daftar route yang sama.
+package playground
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"learn-go/i18n"
+)
+
+// maxBodyBytes membatasi ukuran body request.
+const maxBodyBytes = 1 << 20
+
+// Route adalah satu endpoint JSON. Request dan Response adalah tipe struct
+// yang dipakai untuk decode/encode body sekaligus untuk membuat schema
+// OpenAPI.
+type Route struct {
+	Method   string
+	Path     string
+	Tag      string
+	Summary  string
+	Request  reflect.Type
+	Response reflect.Type
+
+	call func(body []byte) (any, error)
+}
+
+// post membuat Route POST yang men-decode body menjadi Req, memvalidasinya
+// sesuai tag struct, lalu memanggil fn.
+func post[Req, Resp any](path, tag, summary string, fn func(Req) (Resp, error)) Route {
+	return Route{
+		Method:   http.MethodPost,
+		Path:     path,
+		Tag:      tag,
+		Summary:  summary,
+		Request:  reflect.TypeFor[Req](),
+		Response: reflect.TypeFor[Resp](),
+		call: func(body []byte) (any, error) {
+			var req Req
+			if err := bind(body, &req); err != nil {
+				return nil, err
+			}
+			return fn(req)
+		},
+	}
+}
+
+// NewHandler membuat http.Handler untuk semua route dan GET /openapi.json.
+// Pesan error memakai bahasa dari header Accept-Language, atau l jika
+// header tidak ada atau tidak didukung.
+func NewHandler(l i18n.Locale) http.Handler {
+	mux := http.NewServeMux()
+	for _, rt := range Routes() {
+		mux.Handle(rt.Method+" "+rt.Path, rt.handler(l))
+	}
+
+	doc, err := json.MarshalIndent(OpenAPI(), "", "  ")
+	if err != nil {
+		panic("playground: gagal membuat dokumen OpenAPI: " + err.Error())
+	}
+	mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(doc)
+	})
+	return mux
+}
+
+func (rt Route) handler(l i18n.Locale) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		msg := i18n.NewPrinter(requestLocale(r, l))
+
+		if ct := r.Header.Get("Content-Type"); ct != "" {
+			if mt, _, err := mime.ParseMediaType(ct); err != nil || (mt != "application/json" && !strings.HasSuffix(mt, "+json")) {
+				writeError(w, msg, newError(http.StatusUnsupportedMediaType, "unsupported_media_type", "", "playground.error.media_type"))
+				return
+			}
+		}
+
+		body, err := readBody(w, r)
+		if err != nil {
+			writeError(w, msg, err)
+			return
+		}
+		resp, err := rt.call(body)
+		if err != nil {
+			writeError(w, msg, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, resp)
+	})
+}
+
+func readBody(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return nil, newError(http.StatusRequestEntityTooLarge, "body_too_large", "", "playground.error.body_too_large", maxBodyBytes)
+	}
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, "invalid_body", "", "playground.error.read_body")
+	}
+	return body, nil
+}
+
+// requestLocale mengambil locale pertama yang didukung dari header
+// Accept-Language, misalnya "en-US,en;q=0.9".
+func requestLocale(r *http.Request, fallback i18n.Locale) i18n.Locale {
+	for _, part := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		tag, _, _ := strings.Cut(part, ";")
+		if l, err := i18n.Parse(strings.TrimSpace(tag)); err == nil {
+			return l
+		}
+	}
+	return fallback
+}
+
+// ========== ERROR ==========
+
+// Error adalah kesalahan input yang dikirim ke klien sebagai respons 4xx.
+// Code dan Field stabil untuk dipakai program; pesannya diterjemahkan.
+type Error struct {
+	Status int
+	Code   string
+	Field  string
+
+	key  string
+	args []any
+}
+
+func newError(status int, code, field, key string, args ...any) *Error {
+	return &Error{Status: status, Code: code, Field: field, key: key, args: args}
+}
+
+// invalid membuat Error 422 untuk nilai field yang tidak bisa diproses.
+func invalid(field, code, key string, args ...any) *Error {
+	return newError(http.StatusUnprocessableEntity, code, field, key, args...)
+}
+
+func (e *Error) Error() string {
+	return i18n.NewPrinter(i18n.Default).Sprintf(e.key, e.args...)
+}
+
+// errorBody adalah bentuk JSON setiap respons error.
+type errorBody struct {
+	Error errorDetail `json:"error"`
+}
+
+type errorDetail struct {
+	Code    string `json:"code" doc:"stable error code, e.g. missing_field"`
+	Field   string `json:"field,omitempty" doc:"offending request field"`
+	Message string `json:"message" doc:"human-readable message in the Accept-Language locale"`
+}
+
+func writeError(w http.ResponseWriter, msg *i18n.Printer, err error) {
+	var e *Error
+	if !errors.As(err, &e) {
+		e = newError(http.StatusInternalServerError, "internal", "", "playground.error.internal")
+	}
+	writeJSON(w, e.Status, errorBody{errorDetail{
+		Code:    e.Code,
+		Field:   e.Field,
+		Message: msg.Sprintf(e.key, e.args...),
+	}})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
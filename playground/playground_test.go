@@ -0,0 +1,143 @@
+package playground
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"learn-go/i18n"
+)
+
+func do(t *testing.T, h http.Handler, path, body string, header map[string]string) (int, map[string]any) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var got map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("%s: respons bukan JSON: %q", path, rec.Body.String())
+	}
+	return rec.Code, got
+}
+
+func TestRoutes(t *testing.T) {
+	h := NewHandler(i18n.Indonesian)
+	tests := []struct {
+		path, body string
+		want       string
+	}{
+		{"/v1/validate/nik", `{"nik":"3201234567890001"}`, `{"valid":true}`},
+		{"/v1/validate/phone", `{"phone":"12345"}`, `{"valid":false}`},
+		{"/v1/text/count-words", `{"text":"Go go GO"}`, `{"counts":{"go":3}}`},
+		{"/v1/format/number", `{"n":1234567}`, `{"result":"1,234,567"}`},
+		{"/v1/format/number", `{"n":-1234567}`, `{"result":"-1,234,567"}`},
+		{"/v1/format/bytes", `{"bytes":1536}`, `{"result":"1.5 KB"}`},
+		{"/v1/format/bytes", `{"bytes":9223372036854775807}`, `{"result":"8.0 EB"}`},
+		{"/v1/math/divide", `{"a":10,"b":4}`, `{"result":2.5}`},
+		{"/v1/math/prime", `{"n":999999999989}`, `{"prime":true}`},
+		{"/v1/collections/binary-search", `{"numbers":[1,3,5,7],"target":5}`, `{"index":2}`},
+		{"/v1/shapes/rectangle", `{"width":2,"height":3}`, `{"area":6,"description":"Rectangle - Area: 6.00, Perimeter: 10.00","perimeter":10,"type":"Rectangle"}`},
+	}
+	for _, tt := range tests {
+		code, got := do(t, h, tt.path, tt.body, nil)
+		b, _ := json.Marshal(got)
+		if code != http.StatusOK || string(b) != tt.want {
+			t.Errorf("%s %s = %d %s, want 200 %s", tt.path, tt.body, code, b, tt.want)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	h := NewHandler(i18n.Indonesian)
+	tests := []struct {
+		path, body string
+		status     int
+		code       string
+		field      string
+	}{
+		{"/v1/math/factorial", ``, http.StatusBadRequest, "empty_body", ""},
+		{"/v1/math/factorial", `{"n":`, http.StatusBadRequest, "invalid_json", ""},
+		{"/v1/math/factorial", `[1]`, http.StatusBadRequest, "invalid_json", ""},
+		{"/v1/math/factorial", `{}`, http.StatusBadRequest, "missing_field", "n"},
+		{"/v1/math/factorial", `{"n":"5"}`, http.StatusBadRequest, "invalid_type", "n"},
+		{"/v1/math/factorial", `{"n":5,"x":1}`, http.StatusBadRequest, "unknown_field", "x"},
+		{"/v1/math/factorial", `{"n":21}`, http.StatusUnprocessableEntity, "out_of_range", "n"},
+		{"/v1/math/prime", `{"n":9223372036854775783}`, http.StatusUnprocessableEntity, "out_of_range", "n"},
+		{"/v1/math/power", `{"base":2,"exp":-1}`, http.StatusUnprocessableEntity, "out_of_range", "exp"},
+		{"/v1/format/bytes", `{"bytes":-1}`, http.StatusUnprocessableEntity, "out_of_range", "bytes"},
+		{"/v1/math/divide", `{"a":1,"b":0}`, http.StatusUnprocessableEntity, "division_by_zero", "b"},
+		{"/v1/shapes/total-area", `{"circles":[{}]}`, http.StatusBadRequest, "missing_field", "circles[0].radius"},
+		{"/v1/shapes/total-area", `{"circles":[{"radius":-1}]}`, http.StatusUnprocessableEntity, "out_of_range", "circles[0].radius"},
+	}
+	for _, tt := range tests {
+		code, got := do(t, h, tt.path, tt.body, nil)
+		e, _ := got["error"].(map[string]any)
+		field, _ := e["field"].(string)
+		if code != tt.status || e["code"] != tt.code || field != tt.field {
+			t.Errorf("%s %s = %d %v, want %d code=%s field=%q", tt.path, tt.body, code, e, tt.status, tt.code, tt.field)
+		}
+	}
+}
+
+func TestErrorLocale(t *testing.T) {
+	h := NewHandler(i18n.Indonesian)
+	body := `{"a":1,"b":0}`
+
+	_, got := do(t, h, "/v1/math/divide", body, nil)
+	if msg := got["error"].(map[string]any)["message"]; msg != "tidak bisa dibagi dengan nol" {
+		t.Errorf("pesan bawaan = %q", msg)
+	}
+	_, got = do(t, h, "/v1/math/divide", body, map[string]string{"Accept-Language": "fr, en-US;q=0.8"})
+	if msg := got["error"].(map[string]any)["message"]; msg != "cannot divide by zero" {
+		t.Errorf("pesan Accept-Language en = %q", msg)
+	}
+}
+
+func TestContentType(t *testing.T) {
+	h := NewHandler(i18n.Indonesian)
+	code, _ := do(t, h, "/v1/text/reverse", `{"text":"go"}`, map[string]string{"Content-Type": "text/plain"})
+	if code != http.StatusUnsupportedMediaType {
+		t.Errorf("status = %d, want 415", code)
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewHandler(i18n.Indonesian).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	var doc struct {
+		Paths map[string]map[string]struct {
+			RequestBody struct {
+				Content map[string]struct {
+					Schema map[string]any
+				}
+			}
+		}
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	for _, rt := range Routes() {
+		op, ok := doc.Paths[rt.Path][strings.ToLower(rt.Method)]
+		if !ok {
+			t.Errorf("route %s %s tidak ada di dokumen", rt.Method, rt.Path)
+			continue
+		}
+		if op.RequestBody.Content["application/json"].Schema["type"] != "object" {
+			t.Errorf("route %s tidak punya schema request", rt.Path)
+		}
+	}
+
+	schema := doc.Paths["/v1/math/factorial"]["post"].RequestBody.Content["application/json"].Schema
+	n := schema["properties"].(map[string]any)["n"].(map[string]any)
+	if n["minimum"] != 0.0 || n["maximum"] != 20.0 {
+		t.Errorf("schema factorial n = %v, want minimum 0 maximum 20", n)
+	}
+}
@@ -0,0 +1,277 @@
+package playground
+
+import (
+	"errors"
+
+	"learn-go/collections"
+	"learn-go/mathx"
+	"learn-go/shapes"
+	"learn-go/textutil"
+	"learn-go/timeutil"
+	"learn-go/validate"
+)
+
+// ========== SCHEMA REQUEST DAN RESPONSE ==========
+
+type (
+	nikRequest struct {
+		NIK string `json:"nik" doc:"16-digit Indonesian national ID number"`
+	}
+	phoneRequest struct {
+		Phone string `json:"phone" doc:"Indonesian phone number, e.g. 081234567890"`
+	}
+	emailRequest struct {
+		Email string `json:"email" doc:"email address"`
+	}
+	validResponse struct {
+		Valid bool `json:"valid"`
+	}
+
+	textRequest struct {
+		Text string `json:"text"`
+	}
+	textResponse struct {
+		Result string `json:"result"`
+	}
+	palindromeResponse struct {
+		Palindrome bool `json:"palindrome"`
+	}
+	wordCountResponse struct {
+		Counts map[string]int `json:"counts" doc:"occurrences of each lower-cased word"`
+	}
+
+	formatNumberRequest struct {
+		N int `json:"n"`
+	}
+	bytesRequest struct {
+		Bytes int64 `json:"bytes" min:"0"`
+	}
+
+	numberRequest struct {
+		N int `json:"n" max:"1000000000000" doc:"checked by trial division, hence the limit"`
+	}
+	factorialRequest struct {
+		N int `json:"n" min:"0" max:"20"`
+	}
+	fibonacciRequest struct {
+		N int `json:"n" min:"0" max:"35" doc:"computed recursively, hence the limit"`
+	}
+	powerRequest struct {
+		Base int `json:"base"`
+		Exp  int `json:"exp" min:"0" max:"62"`
+	}
+	pairRequest struct {
+		A int `json:"a"`
+		B int `json:"b"`
+	}
+	divideRequest struct {
+		A float64 `json:"a"`
+		B float64 `json:"b" doc:"must not be zero"`
+	}
+	intResponse struct {
+		Result int `json:"result"`
+	}
+	floatResponse struct {
+		Result float64 `json:"result"`
+	}
+	primeResponse struct {
+		Prime bool `json:"prime"`
+	}
+
+	celsiusRequest struct {
+		Celsius float64 `json:"celsius" min:"-273.15"`
+	}
+	fahrenheitResponse struct {
+		Fahrenheit float64 `json:"fahrenheit"`
+	}
+	yearRequest struct {
+		Year int `json:"year" min:"1"`
+	}
+	leapYearResponse struct {
+		LeapYear bool `json:"leap_year"`
+	}
+
+	searchRequest struct {
+		Numbers []int `json:"numbers" doc:"must be sorted in ascending order"`
+		Target  int   `json:"target"`
+	}
+	searchResponse struct {
+		Index int `json:"index" doc:"index of target, or -1 if not found"`
+	}
+	numbersRequest struct {
+		Numbers []int `json:"numbers"`
+	}
+	statsResponse struct {
+		Sum     int     `json:"sum"`
+		Min     int     `json:"min"`
+		Max     int     `json:"max"`
+		Average float64 `json:"average"`
+	}
+
+	rectangleRequest struct {
+		Width  float64 `json:"width" min:"0"`
+		Height float64 `json:"height" min:"0"`
+	}
+	circleRequest struct {
+		Radius float64 `json:"radius" min:"0"`
+	}
+	triangleRequest struct {
+		Base   float64 `json:"base" min:"0"`
+		Height float64 `json:"height" min:"0"`
+		Side1  float64 `json:"side1" min:"0"`
+		Side2  float64 `json:"side2" min:"0"`
+	}
+	shapeResponse struct {
+		Type        string  `json:"type"`
+		Area        float64 `json:"area"`
+		Perimeter   float64 `json:"perimeter"`
+		Description string  `json:"description"`
+	}
+	totalAreaRequest struct {
+		Rectangles []rectangleRequest `json:"rectangles,omitempty"`
+		Circles    []circleRequest    `json:"circles,omitempty"`
+		Triangles  []triangleRequest  `json:"triangles,omitempty"`
+	}
+	totalAreaResponse struct {
+		Shapes    []shapeResponse `json:"shapes"`
+		TotalArea float64         `json:"total_area"`
+	}
+)
+
+// ========== DAFTAR ROUTE ==========
+
+// Routes mengembalikan semua endpoint playground, terurut seperti di
+// dokumen OpenAPI.
+func Routes() []Route {
+	return []Route{
+		post("/v1/validate/nik", "validate", "Validate a 16-digit NIK", func(r nikRequest) (validResponse, error) {
+			return validResponse{validate.IsValidNIK(r.NIK)}, nil
+		}),
+		post("/v1/validate/phone", "validate", "Validate an Indonesian phone number", func(r phoneRequest) (validResponse, error) {
+			return validResponse{validate.IsValidPhoneNumber(r.Phone)}, nil
+		}),
+		post("/v1/validate/email", "validate", "Validate an email address", func(r emailRequest) (validResponse, error) {
+			return validResponse{validate.IsValidEmailRegex(r.Email)}, nil
+		}),
+
+		post("/v1/text/count-words", "text", "Count how often each word appears", func(r textRequest) (wordCountResponse, error) {
+			return wordCountResponse{textutil.CountWords(r.Text)}, nil
+		}),
+		post("/v1/text/clean", "text", "Collapse extra whitespace", func(r textRequest) (textResponse, error) {
+			return textResponse{textutil.CleanString(r.Text)}, nil
+		}),
+		post("/v1/text/title-case", "text", "Convert text to Title Case", func(r textRequest) (textResponse, error) {
+			return textResponse{textutil.ToTitleCase(r.Text)}, nil
+		}),
+		post("/v1/text/reverse", "text", "Reverse text", func(r textRequest) (textResponse, error) {
+			return textResponse{textutil.Reverse(r.Text)}, nil
+		}),
+		post("/v1/text/palindrome", "text", "Check for a palindrome", func(r textRequest) (palindromeResponse, error) {
+			return palindromeResponse{textutil.IsPalindrome(r.Text)}, nil
+		}),
+
+		post("/v1/format/number", "format", "Format a number with thousands separators", func(r formatNumberRequest) (textResponse, error) {
+			return textResponse{textutil.FormatNumber(r.N)}, nil
+		}),
+		post("/v1/format/bytes", "format", "Turn a byte count into a human-readable size", func(r bytesRequest) (textResponse, error) {
+			return textResponse{textutil.BytesToHuman(r.Bytes)}, nil
+		}),
+
+		post("/v1/math/divide", "math", "Divide a by b", func(r divideRequest) (floatResponse, error) {
+			q, err := mathx.Divide(r.A, r.B)
+			if errors.Is(err, mathx.ErrDivisionByZero) {
+				return floatResponse{}, invalid("b", "division_by_zero", "playground.error.division_by_zero")
+			}
+			return floatResponse{q}, err
+		}),
+		post("/v1/math/prime", "math", "Check whether a number is prime", func(r numberRequest) (primeResponse, error) {
+			return primeResponse{mathx.IsPrime(r.N)}, nil
+		}),
+		post("/v1/math/factorial", "math", "Compute n!", func(r factorialRequest) (intResponse, error) {
+			return intResponse{mathx.Factorial(r.N)}, nil
+		}),
+		post("/v1/math/fibonacci", "math", "Compute the n-th Fibonacci number", func(r fibonacciRequest) (intResponse, error) {
+			return intResponse{mathx.Fibonacci(r.N)}, nil
+		}),
+		post("/v1/math/power", "math", "Compute base to the power of exp", func(r powerRequest) (intResponse, error) {
+			return intResponse{mathx.Power(r.Base, r.Exp)}, nil
+		}),
+		post("/v1/math/gcd", "math", "Greatest common divisor of a and b", func(r pairRequest) (intResponse, error) {
+			return intResponse{mathx.GCD(r.A, r.B)}, nil
+		}),
+		post("/v1/convert/celsius", "convert", "Convert Celsius to Fahrenheit", func(r celsiusRequest) (fahrenheitResponse, error) {
+			return fahrenheitResponse{mathx.CelsiusToFahrenheit(r.Celsius)}, nil
+		}),
+		post("/v1/time/leap-year", "time", "Check whether a year is a leap year", func(r yearRequest) (leapYearResponse, error) {
+			return leapYearResponse{timeutil.IsLeapYear(r.Year)}, nil
+		}),
+
+		post("/v1/collections/stats", "collections", "Sum, minimum, maximum and average of a list", func(r numbersRequest) (statsResponse, error) {
+			if len(r.Numbers) == 0 {
+				return statsResponse{}, invalid("numbers", "empty_list", "playground.error.empty_list", "numbers")
+			}
+			return statsResponse{
+				Sum:     collections.SumArray(r.Numbers),
+				Min:     collections.FindMin(r.Numbers),
+				Max:     collections.FindMax(r.Numbers),
+				Average: collections.Average(r.Numbers),
+			}, nil
+		}),
+		post("/v1/collections/binary-search", "collections", "Find target in a sorted list", func(r searchRequest) (searchResponse, error) {
+			for i := 1; i < len(r.Numbers); i++ {
+				if r.Numbers[i] < r.Numbers[i-1] {
+					return searchResponse{}, invalid("numbers", "not_sorted", "playground.error.not_sorted", "numbers")
+				}
+			}
+			return searchResponse{collections.BinarySearch(r.Numbers, r.Target)}, nil
+		}),
+
+		post("/v1/shapes/rectangle", "shapes", "Area and perimeter of a rectangle", func(r rectangleRequest) (shapeResponse, error) {
+			return describe(r.shape()), nil
+		}),
+		post("/v1/shapes/circle", "shapes", "Area and perimeter of a circle", func(r circleRequest) (shapeResponse, error) {
+			return describe(r.shape()), nil
+		}),
+		post("/v1/shapes/triangle", "shapes", "Area and perimeter of a triangle", func(r triangleRequest) (shapeResponse, error) {
+			return describe(r.shape()), nil
+		}),
+		post("/v1/shapes/total-area", "shapes", "Total area of several shapes", func(r totalAreaRequest) (totalAreaResponse, error) {
+			var all []shapes.Shape
+			for _, s := range r.Rectangles {
+				all = append(all, s.shape())
+			}
+			for _, s := range r.Circles {
+				all = append(all, s.shape())
+			}
+			for _, s := range r.Triangles {
+				all = append(all, s.shape())
+			}
+			resp := totalAreaResponse{Shapes: []shapeResponse{}, TotalArea: shapes.TotalArea(all)}
+			for _, s := range all {
+				resp.Shapes = append(resp.Shapes, describe(s))
+			}
+			return resp, nil
+		}),
+	}
+}
+
+func (r rectangleRequest) shape() shapes.Shape {
+	return shapes.Rectangle{Width: r.Width, Height: r.Height}
+}
+
+func (r circleRequest) shape() shapes.Shape {
+	return shapes.Circle{Radius: r.Radius}
+}
+
+func (r triangleRequest) shape() shapes.Shape {
+	return shapes.Triangle{Base: r.Base, Height: r.Height, Side1: r.Side1, Side2: r.Side2}
+}
+
+func describe(s shapes.Shape) shapeResponse {
+	return shapeResponse{
+		Type:        s.GetType(),
+		Area:        s.Area(),
+		Perimeter:   s.Perimeter(),
+		Description: shapes.Describe(s),
+	}
+}
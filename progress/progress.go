@@ -0,0 +1,105 @@
+// Package progress menyimpan riwayat belajar pengguna: demo dan section yang
+// sudah dijalankan beserta waktunya, dan hasil latihan. Riwayat disimpan
+// sebagai satu file JSON di direktori konfigurasi pengguna.
+package progress
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"learn-go/exercise"
+)
+
+// MaxRuns membatasi jumlah run yang disimpan; run tertua dibuang lebih dulu.
+const MaxRuns = 5000
+
+// Run adalah satu kali menjalankan demo.
+type Run struct {
+	Demo    string    `json:"demo"`
+	Section int       `json:"section,omitempty"` // 0 berarti semua section
+	At      time.Time `json:"at"`
+}
+
+// History adalah isi file riwayat. Progres latihan disimpan di field
+// "exercises".
+type History struct {
+	Runs []Run `json:"runs"`
+	exercise.Progress
+}
+
+// DefaultPath mengembalikan lokasi file riwayat, yaitu $LEARN_GO_PROGRESS
+// atau <direktori konfigurasi pengguna>/learn-go/progress.json.
+func DefaultPath() (string, error) {
+	if path := os.Getenv("LEARN_GO_PROGRESS"); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "learn-go", "progress.json"), nil
+}
+
+// Load membaca riwayat dari path. File yang belum ada menghasilkan riwayat
+// kosong.
+func Load(path string) (*History, error) {
+	h := &History{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, h); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// Save menulis h ke path lewat file sementara agar file lama tidak rusak
+// jika penulisan gagal di tengah jalan.
+func (h *History) Save(path string) error {
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".progress-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Reset menghapus file riwayat di path. File yang belum ada bukan error.
+func Reset(path string) error {
+	err := os.Remove(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// RecordRun mencatat bahwa section demo dijalankan pada waktu at. section 0
+// berarti semua section.
+func (h *History) RecordRun(demo string, section int, at time.Time) {
+	h.Runs = append(h.Runs, Run{Demo: demo, Section: section, At: at})
+	if len(h.Runs) > MaxRuns {
+		h.Runs = append([]Run(nil), h.Runs[len(h.Runs)-MaxRuns:]...)
+	}
+}
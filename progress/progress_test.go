@@ -0,0 +1,106 @@
+package progress
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"learn-go/exercise"
+)
+
+func TestSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "learn-go", "progress.json")
+
+	h, err := Load(path)
+	if err != nil || len(h.Runs) != 0 {
+		t.Fatalf("Load file yang belum ada = %+v, %v", h, err)
+	}
+
+	at := time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
+	h.RecordRun("basic", 0, at)
+	h.RecordRun("structs", 2, at.Add(time.Minute))
+	e, _ := exercise.Lookup("basic/min-max")
+	h.RecordResult(e, exercise.Result{Passed: true}, at)
+	if err := h.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Runs) != 2 || got.Runs[1] != (Run{Demo: "structs", Section: 2, At: at.Add(time.Minute)}) {
+		t.Errorf("Runs = %+v", got.Runs)
+	}
+	if r := got.Exercises[e.ID]; r == nil || !r.Passed || r.Score != e.MaxPoints() {
+		t.Errorf("record latihan = %+v", r)
+	}
+
+	if err := Reset(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := Reset(path); err != nil {
+		t.Errorf("Reset file yang sudah dihapus = %v", err)
+	}
+	if h, _ := Load(path); len(h.Runs) != 0 {
+		t.Errorf("riwayat setelah Reset = %+v", h.Runs)
+	}
+}
+
+func TestRecordRunKeepsNewest(t *testing.T) {
+	var h History
+	at := time.Date(2026, time.March, 14, 0, 0, 0, 0, time.UTC)
+	for i := range MaxRuns + 10 {
+		h.RecordRun("basic", 0, at.Add(time.Duration(i)*time.Second))
+	}
+	if len(h.Runs) != MaxRuns {
+		t.Fatalf("len(Runs) = %d, want %d", len(h.Runs), MaxRuns)
+	}
+	if want := at.Add(10 * time.Second); !h.Runs[0].At.Equal(want) {
+		t.Errorf("run tertua = %v, want %v", h.Runs[0].At, want)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	var h History
+	at := time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
+	h.RecordRun("structs", 2, at)
+	h.RecordRun("structs", 2, at.Add(time.Hour))
+	h.RecordRun("basic", 0, at)
+
+	for _, s := range h.Summarize() {
+		switch s.Demo.ID {
+		case "structs":
+			if s.SectionsRun != 1 || s.Runs != 2 || !s.LastRun.Equal(at.Add(time.Hour)) {
+				t.Errorf("structs = %+v", s)
+			}
+		case "basic":
+			if s.SectionsRun != len(s.Demo.Sections) || s.Runs != 1 {
+				t.Errorf("basic: section %d/%d, run %d", s.SectionsRun, len(s.Demo.Sections), s.Runs)
+			}
+		default:
+			if s.Runs != 0 || !s.LastRun.IsZero() || s.Exercises == 0 {
+				t.Errorf("%s = %+v", s.Demo.ID, s)
+			}
+		}
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var h History
+	at := time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
+	h.RecordRun("basic", 0, at)
+	h.RecordRun("structs", 2, at)
+
+	var b strings.Builder
+	if err := h.WriteCSV(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := "time,demo,section\n" +
+		"2026-03-14T09:30:00Z,basic,\n" +
+		"2026-03-14T09:30:00Z,structs,2\n"
+	if b.String() != want {
+		t.Errorf("WriteCSV =\n%s\nwant\n%s", b.String(), want)
+	}
+}
@@ -0,0 +1,80 @@
+package progress
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"learn-go/demo"
+	"learn-go/exercise"
+)
+
+// ========== RINGKASAN ==========
+
+// Summary adalah ringkasan progres untuk satu demo.
+type Summary struct {
+	Demo            demo.Demo
+	SectionsRun     int // jumlah section berbeda yang pernah dijalankan
+	Runs            int
+	LastRun         time.Time // nol jika belum pernah dijalankan
+	ExercisesPassed int
+	Exercises       int
+	Score, MaxScore int
+}
+
+// Summarize menghitung ringkasan untuk setiap demo terdaftar, terurut
+// seperti menu.
+func (h *History) Summarize() []Summary {
+	var list []Summary
+	for _, d := range demo.All() {
+		s := Summary{Demo: d}
+		seen := make(map[int]bool)
+		for _, r := range h.Runs {
+			if r.Demo != d.ID {
+				continue
+			}
+			s.Runs++
+			if r.At.After(s.LastRun) {
+				s.LastRun = r.At
+			}
+			if r.Section == 0 {
+				for i := range d.Sections {
+					seen[i+1] = true
+				}
+			} else if r.Section <= len(d.Sections) {
+				seen[r.Section] = true
+			}
+		}
+		s.SectionsRun = len(seen)
+
+		for _, e := range exercise.InCategory(d.ID) {
+			s.Exercises++
+			s.MaxScore += e.MaxPoints()
+			if r, ok := h.Exercises[e.ID]; ok && r.Passed {
+				s.ExercisesPassed++
+				s.Score += r.Score
+			}
+		}
+		list = append(list, s)
+	}
+	return list
+}
+
+// ========== EKSPOR ==========
+
+// WriteCSV menulis setiap run sebagai satu baris CSV dengan kolom
+// time, demo, section. Section kosong berarti semua section.
+func (h *History) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"time", "demo", "section"})
+	for _, r := range h.Runs {
+		section := ""
+		if r.Section != 0 {
+			section = strconv.Itoa(r.Section)
+		}
+		cw.Write([]string{r.At.Format(time.RFC3339), r.Demo, section})
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"learn-go/demo"
+	"learn-go/progress"
+)
+
+// loadHistory memuat riwayat belajar sekali per proses. Menu interaktif,
+// latihan dan subcommand berbagi nilai yang sama agar tidak saling menimpa.
+func (a *app) loadHistory() (*progress.History, error) {
+	if a.history != nil {
+		return a.history, nil
+	}
+	path, err := progress.DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+	h, err := progress.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	a.history, a.historyPath = h, path
+	return h, nil
+}
+
+func (a *app) saveHistory() error {
+	if a.history == nil {
+		return nil
+	}
+	return a.history.Save(a.historyPath)
+}
+
+// recordRun mencatat run demo ke riwayat. Kegagalan hanya dilaporkan ke
+// stderr karena tidak boleh menggagalkan demo yang sudah berjalan.
+func (a *app) recordRun(id string, section int) {
+	if err := a.saveRun(id, section); err != nil {
+		fmt.Fprint(a.stderr, a.msg.Sprintf("progress.save_failed", err))
+	}
+}
+
+// saveRun mencatat dan langsung menyimpan satu run demo.
+func (a *app) saveRun(id string, section int) error {
+	h, err := a.loadHistory()
+	if err != nil {
+		return err
+	}
+	h.RecordRun(id, section, a.clock.Now())
+	return a.saveHistory()
+}
+
+// recordRunAll mencatat run semua demo dengan waktu yang sama.
+func (a *app) recordRunAll() {
+	h, err := a.loadHistory()
+	if err == nil {
+		now := a.clock.Now()
+		for _, d := range demo.All() {
+			h.RecordRun(d.ID, 0, now)
+		}
+		err = a.saveHistory()
+	}
+	if err != nil {
+		fmt.Fprint(a.stderr, a.msg.Sprintf("progress.save_failed", err))
+	}
+}
+
+func (a *app) runProgress(args []string) int {
+	fs := a.newFlagSet("progress", func(fs *flag.FlagSet) {
+		fmt.Fprint(fs.Output(), a.msg.T("cli.progress.usage"))
+		fs.PrintDefaults()
+	})
+	format := fs.String("format", "json", a.msg.T("cli.flag.export_format"))
+	yes := fs.Bool("yes", false, a.msg.T("cli.flag.yes"))
+
+	// Aksi boleh ditulis sebelum flag, misalnya "progress reset --yes".
+	action := "show"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		action, args = args[0], args[1:]
+	}
+	if code, ok := a.parse(fs, args); !ok {
+		return code
+	}
+	if fs.NArg() > 1 || (fs.NArg() == 1 && action != "show") {
+		fs.Usage()
+		return exitUsage
+	}
+	if fs.NArg() == 1 {
+		action = fs.Arg(0)
+	}
+
+	h, err := a.loadHistory()
+	if err != nil {
+		fmt.Fprintf(a.stderr, "learn-go progress: %v\n", err)
+		return exitFail
+	}
+
+	switch action {
+	case "show":
+		a.printProgress(h)
+	case "reset":
+		if !*yes {
+			fmt.Fprint(a.stderr, a.msg.Sprintf("progress.reset_confirm", a.historyPath))
+			return exitUsage
+		}
+		if err := progress.Reset(a.historyPath); err != nil {
+			fmt.Fprintf(a.stderr, "learn-go progress: %v\n", err)
+			return exitFail
+		}
+		a.history = &progress.History{}
+		fmt.Fprintln(a.stdout, a.msg.T("progress.reset_done"))
+	case "export":
+		switch *format {
+		case "json":
+			enc := json.NewEncoder(a.stdout)
+			enc.SetIndent("", "  ")
+			err = enc.Encode(h)
+		case "csv":
+			err = h.WriteCSV(a.stdout)
+		default:
+			fmt.Fprint(a.stderr, a.msg.Sprintf("progress.unknown_format", *format))
+			return exitUsage
+		}
+		if err != nil {
+			fmt.Fprint(a.stderr, a.msg.Sprintf("cli.write_failed", err))
+			return exitFail
+		}
+	default:
+		fs.Usage()
+		return exitUsage
+	}
+	return exitOK
+}
+
+// printProgress menampilkan tabel ringkasan per kategori.
+func (a *app) printProgress(h *progress.History) {
+	l := a.msg.Locale()
+	tw := tabwriter.NewWriter(a.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, a.msg.T("progress.table_header"))
+
+	var total progress.Summary
+	sections := 0
+	for _, s := range h.Summarize() {
+		last := "-"
+		if !s.LastRun.IsZero() {
+			last = s.LastRun.Local().Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(tw, "%s\t%d/%d\t%d\t%s\t%d/%d\t%d/%d\n",
+			s.Demo.Title.In(l), s.SectionsRun, len(s.Demo.Sections), s.Runs, last,
+			s.ExercisesPassed, s.Exercises, s.Score, s.MaxScore)
+
+		total.SectionsRun += s.SectionsRun
+		total.Runs += s.Runs
+		total.ExercisesPassed += s.ExercisesPassed
+		total.Exercises += s.Exercises
+		total.Score += s.Score
+		total.MaxScore += s.MaxScore
+		sections += len(s.Demo.Sections)
+	}
+	fmt.Fprintf(tw, "%s\t%d/%d\t%d\t\t%d/%d\t%d/%d\n",
+		a.msg.T("progress.total"), total.SectionsRun, sections, total.Runs,
+		total.ExercisesPassed, total.Exercises, total.Score, total.MaxScore)
+	tw.Flush()
+
+	fmt.Fprintln(a.stdout)
+	fmt.Fprint(a.stdout, a.msg.Sprintf("progress.file", a.historyPath))
+}
@@ -0,0 +1,294 @@
+// Package pubsub berisi broker pesan di dalam proses: publisher mengirim
+// pesan ke topic, dan setiap subscriber yang pola topic-nya cocok menerima
+// salinannya lewat channel sendiri (fan-out). Setiap subscriber punya
+// antrean dengan kebijakan sendiri saat penuh, sehingga subscriber yang
+// lambat tidak memperlambat yang lain kecuali memang diminta (Block).
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	// ErrClosed dikembalikan Publish dan Subscribe setelah broker ditutup.
+	ErrClosed = errors.New("pubsub: broker sudah ditutup")
+	// ErrInvalidTopic dikembalikan untuk topic atau pola yang tidak valid.
+	ErrInvalidTopic = errors.New("pubsub: topic tidak valid")
+)
+
+// Policy menentukan apa yang terjadi saat antrean subscriber penuh.
+type Policy int
+
+const (
+	// Block membuat Publish menunggu sampai ada tempat, subscriber
+	// berhenti, atau context publisher batal.
+	Block Policy = iota
+	// DropNewest membuang pesan yang baru datang.
+	DropNewest
+	// DropOldest membuang pesan terlama di antrean agar pesan baru masuk.
+	DropOldest
+)
+
+func (p Policy) String() string {
+	switch p {
+	case Block:
+		return "block"
+	case DropNewest:
+		return "drop-newest"
+	case DropOldest:
+		return "drop-oldest"
+	}
+	return "unknown"
+}
+
+// Message adalah satu pesan beserta topic tujuannya.
+type Message[T any] struct {
+	Topic   string
+	Payload T
+}
+
+// Options mengatur satu Subscription. Nilai nol berarti antrean tanpa
+// buffer dengan kebijakan Block.
+type Options struct {
+	Buffer int
+	Policy Policy
+}
+
+// Broker mengirim pesan bertipe T ke subscriber. Buat dengan New; aman
+// dipakai bersama oleh banyak goroutine.
+type Broker[T any] struct {
+	mu     sync.RWMutex
+	subs   map[*Subscription[T]]struct{}
+	closed bool
+}
+
+// New membuat Broker tanpa subscriber.
+func New[T any]() *Broker[T] {
+	return &Broker[T]{subs: make(map[*Subscription[T]]struct{})}
+}
+
+// Subscribe mendaftarkan subscriber untuk semua topic yang cocok dengan
+// pattern (lihat Match). Pesan dibaca dari Subscription.C sampai channel
+// tertutup oleh Unsubscribe atau Broker.Close.
+func (b *Broker[T]) Subscribe(pattern string, opts Options) (*Subscription[T], error) {
+	if !validPattern(pattern) {
+		return nil, ErrInvalidTopic
+	}
+	s := &Subscription[T]{
+		pattern: pattern,
+		policy:  opts.Policy,
+		ch:      make(chan Message[T], max(opts.Buffer, 0)),
+		done:    make(chan struct{}),
+		broker:  b,
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil, ErrClosed
+	}
+	b.subs[s] = struct{}{}
+	return s, nil
+}
+
+// Publish mengirim payload ke semua subscriber yang cocok dengan topic dan
+// mengembalikan jumlah subscriber yang menerimanya. Pesan yang dibuang
+// karena antrean penuh tidak dihitung. Jika ctx batal saat menunggu
+// subscriber Block, Publish berhenti dan mengembalikan ctx.Err() bersama
+// jumlah yang sudah terkirim.
+func (b *Broker[T]) Publish(ctx context.Context, topic string, payload T) (int, error) {
+	if !validTopic(topic) {
+		return 0, ErrInvalidTopic
+	}
+	b.mu.RLock()
+	if b.closed {
+		b.mu.RUnlock()
+		return 0, ErrClosed
+	}
+	var targets []*Subscription[T]
+	for s := range b.subs {
+		if Match(s.pattern, topic) {
+			targets = append(targets, s)
+		}
+	}
+	b.mu.RUnlock()
+
+	msg := Message[T]{Topic: topic, Payload: payload}
+	delivered := 0
+	for _, s := range targets {
+		ok, err := s.deliver(ctx, msg)
+		if err != nil {
+			return delivered, err
+		}
+		if ok {
+			delivered++
+		}
+	}
+	return delivered, nil
+}
+
+// Close menutup semua subscription dan menolak Publish dan Subscribe
+// berikutnya. Publish yang sedang menunggu subscriber Block berhenti tanpa
+// error. Close aman dipanggil berkali-kali.
+func (b *Broker[T]) Close() {
+	b.mu.Lock()
+	subs := b.subs
+	b.subs = make(map[*Subscription[T]]struct{})
+	b.closed = true
+	b.mu.Unlock()
+
+	for s := range subs {
+		s.close()
+	}
+}
+
+// ========== SUBSCRIPTION ==========
+
+// Subscription adalah satu subscriber pada Broker.
+type Subscription[T any] struct {
+	pattern string
+	policy  Policy
+	ch      chan Message[T]
+	broker  *Broker[T]
+	dropped atomic.Uint64
+
+	// done ditutup lebih dulu agar pengirim yang sedang menunggu berhenti;
+	// ch baru ditutup setelah semua pengirim melepas sending, sehingga
+	// tidak pernah ada pengiriman ke channel yang sudah tertutup.
+	done    chan struct{}
+	once    sync.Once
+	sending sync.RWMutex
+}
+
+// C mengembalikan channel pesan. Channel ditutup setelah Unsubscribe atau
+// Broker.Close; pesan yang masih di antrean tetap bisa dibaca.
+func (s *Subscription[T]) C() <-chan Message[T] {
+	return s.ch
+}
+
+// Pattern mengembalikan pola topic subscription.
+func (s *Subscription[T]) Pattern() string {
+	return s.pattern
+}
+
+// Dropped mengembalikan jumlah pesan yang dibuang karena antrean penuh.
+func (s *Subscription[T]) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Unsubscribe berhenti menerima pesan dan menutup C. Aman dipanggil
+// berkali-kali dan bersamaan dengan Publish.
+func (s *Subscription[T]) Unsubscribe() {
+	s.broker.mu.Lock()
+	delete(s.broker.subs, s)
+	s.broker.mu.Unlock()
+	s.close()
+}
+
+func (s *Subscription[T]) close() {
+	s.once.Do(func() {
+		close(s.done)
+		s.sending.Lock()
+		close(s.ch)
+		s.sending.Unlock()
+	})
+}
+
+// deliver memasukkan msg ke antrean sesuai kebijakan. Nilai pertama false
+// berarti pesan tidak sampai: dibuang atau subscription sudah berhenti.
+func (s *Subscription[T]) deliver(ctx context.Context, msg Message[T]) (bool, error) {
+	s.sending.RLock()
+	defer s.sending.RUnlock()
+	select {
+	case <-s.done:
+		return false, nil
+	default:
+	}
+
+	switch s.policy {
+	case DropNewest:
+		select {
+		case s.ch <- msg:
+			return true, nil
+		default:
+			s.dropped.Add(1)
+			return false, nil
+		}
+	case DropOldest:
+		for {
+			select {
+			case s.ch <- msg:
+				return true, nil
+			default:
+			}
+			// Antrean penuh: buang satu pesan terlama lalu coba lagi.
+			// Antrean tanpa buffer tidak punya pesan untuk dibuang.
+			select {
+			case <-s.ch:
+				s.dropped.Add(1)
+			default:
+				if cap(s.ch) == 0 {
+					s.dropped.Add(1)
+					return false, nil
+				}
+			}
+		}
+	default:
+		select {
+		case s.ch <- msg:
+			return true, nil
+		case <-s.done:
+			return false, nil
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+}
+
+// ========== TOPIC ==========
+
+// Match melaporkan apakah topic cocok dengan pattern. Topic terdiri dari
+// segmen yang dipisah titik, misalnya "order.created". Di pattern, "*"
+// cocok dengan tepat satu segmen dan "#" (hanya sebagai segmen terakhir)
+// cocok dengan nol atau lebih segmen sisanya: "order.*" cocok dengan
+// "order.created" tetapi tidak dengan "order.item.added", sedangkan
+// "order.#" cocok dengan keduanya dan juga "order".
+func Match(pattern, topic string) bool {
+	ps, ts := strings.Split(pattern, "."), strings.Split(topic, ".")
+	for i, p := range ps {
+		if p == "#" {
+			return true
+		}
+		if i >= len(ts) || (p != "*" && p != ts[i]) {
+			return false
+		}
+	}
+	return len(ps) == len(ts)
+}
+
+// validTopic melaporkan apakah topic tidak kosong, tanpa segmen kosong dan
+// tanpa wildcard.
+func validTopic(topic string) bool {
+	for _, seg := range strings.Split(topic, ".") {
+		if seg == "" || seg == "*" || seg == "#" {
+			return false
+		}
+	}
+	return true
+}
+
+// validPattern seperti validTopic tetapi mengizinkan "*" di mana saja dan
+// "#" sebagai segmen terakhir.
+func validPattern(pattern string) bool {
+	segs := strings.Split(pattern, ".")
+	for i, seg := range segs {
+		if seg == "" || (seg == "#" && i != len(segs)-1) {
+			return false
+		}
+	}
+	return true
+}
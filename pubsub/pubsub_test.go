@@ -0,0 +1,153 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern, topic string
+		want           bool
+	}{
+		{"order.created", "order.created", true},
+		{"order.created", "order.paid", false},
+		{"order.*", "order.created", true},
+		{"order.*", "order.item.added", false},
+		{"order.*", "order", false},
+		{"*.created", "user.created", true},
+		{"order.#", "order", true},
+		{"order.#", "order.item.added", true},
+		{"#", "apa.saja", true},
+		{"order", "order.created", false},
+	}
+	for _, tt := range tests {
+		if got := Match(tt.pattern, tt.topic); got != tt.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", tt.pattern, tt.topic, got, tt.want)
+		}
+	}
+}
+
+// drain membaca semua payload dari s sampai channel-nya tertutup.
+func drain[T any](s *Subscription[T]) []T {
+	var got []T
+	for msg := range s.C() {
+		got = append(got, msg.Payload)
+	}
+	return got
+}
+
+func TestFanOutAndPolicies(t *testing.T) {
+	b := New[int]()
+	ctx := context.Background()
+	all, _ := b.Subscribe("order.#", Options{Buffer: 10})
+	newest, _ := b.Subscribe("order.*", Options{Buffer: 2, Policy: DropNewest})
+	oldest, _ := b.Subscribe("order.*", Options{Buffer: 2, Policy: DropOldest})
+	other, _ := b.Subscribe("payment.*", Options{Buffer: 10})
+
+	for i := 1; i <= 4; i++ {
+		b.Publish(ctx, "order.created", i)
+	}
+	if n, err := b.Publish(ctx, "order.item.added", 5); n != 1 || err != nil {
+		t.Errorf("Publish = %d, %v, want 1 penerima", n, err)
+	}
+	b.Close()
+
+	if got := drain(all); !slices.Equal(got, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("order.# = %v", got)
+	}
+	if got := drain(newest); !slices.Equal(got, []int{1, 2}) || newest.Dropped() != 2 {
+		t.Errorf("drop-newest = %v, dropped %d", got, newest.Dropped())
+	}
+	if got := drain(oldest); !slices.Equal(got, []int{3, 4}) || oldest.Dropped() != 2 {
+		t.Errorf("drop-oldest = %v, dropped %d", got, oldest.Dropped())
+	}
+	if got := drain(other); len(got) != 0 {
+		t.Errorf("payment.* = %v", got)
+	}
+}
+
+func TestBlock(t *testing.T) {
+	b := New[string]()
+	s, _ := b.Subscribe("a", Options{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if n, err := b.Publish(ctx, "a", "x"); n != 0 || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Publish tanpa pembaca = %d, %v", n, err)
+	}
+
+	// Unsubscribe melepaskan Publish yang sedang menunggu.
+	done := make(chan error)
+	go func() {
+		_, err := b.Publish(context.Background(), "a", "y")
+		done <- err
+	}()
+	time.Sleep(5 * time.Millisecond)
+	s.Unsubscribe()
+	if err := <-done; err != nil {
+		t.Errorf("Publish setelah Unsubscribe = %v", err)
+	}
+	if _, ok := <-s.C(); ok {
+		t.Error("C belum tertutup setelah Unsubscribe")
+	}
+	s.Unsubscribe() // aman dipanggil lagi
+}
+
+func TestErrors(t *testing.T) {
+	b := New[int]()
+	for _, p := range []string{"", "a..b", "a.#.b"} {
+		if _, err := b.Subscribe(p, Options{}); !errors.Is(err, ErrInvalidTopic) {
+			t.Errorf("Subscribe(%q) = %v", p, err)
+		}
+	}
+	if _, err := b.Publish(context.Background(), "a.*", 1); !errors.Is(err, ErrInvalidTopic) {
+		t.Errorf("Publish wildcard = %v", err)
+	}
+	b.Close()
+	b.Close()
+	if _, err := b.Publish(context.Background(), "a", 1); !errors.Is(err, ErrClosed) {
+		t.Errorf("Publish setelah Close = %v", err)
+	}
+	if _, err := b.Subscribe("a", Options{}); !errors.Is(err, ErrClosed) {
+		t.Errorf("Subscribe setelah Close = %v", err)
+	}
+}
+
+// TestConcurrentClose memastikan Publish, Unsubscribe dan Close yang
+// berjalan bersamaan tidak pernah panic karena mengirim ke channel yang
+// sudah tertutup. Jalankan dengan -race.
+func TestConcurrentClose(t *testing.T) {
+	for range 5 {
+		b := New[int]()
+		var subs []*Subscription[int]
+		for _, p := range []Policy{Block, DropNewest, DropOldest} {
+			s, _ := b.Subscribe("t.#", Options{Buffer: 1, Policy: p})
+			subs = append(subs, s)
+		}
+
+		var wg sync.WaitGroup
+		for i := range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; ; j++ {
+					if _, err := b.Publish(context.Background(), "t.x", i*1000+j); errors.Is(err, ErrClosed) {
+						return
+					}
+				}
+			}()
+		}
+		for _, s := range subs {
+			go drain(s)
+		}
+		time.Sleep(time.Millisecond)
+		subs[0].Unsubscribe()
+		b.Close()
+		wg.Wait()
+	}
+}
@@ -0,0 +1,240 @@
+// Package ratelimit berisi pembatas laju untuk memanggil layanan yang
+// dibatasi: token bucket, leaky bucket dan sliding window log. Semua
+// limiter aman dipakai bersama oleh banyak goroutine dan menerima
+// clock.Clock agar bisa diuji secara deterministik.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"learn-go/clock"
+)
+
+// ErrQueueFull dikembalikan LeakyBucket.Wait jika antrean sudah penuh.
+var ErrQueueFull = errors.New("ratelimit: antrean penuh")
+
+// Limiter membatasi laju pemanggilan.
+type Limiter interface {
+	// Allow melaporkan apakah satu pemanggilan boleh dilakukan sekarang,
+	// tanpa menunggu.
+	Allow() bool
+	// Wait menunggu sampai satu pemanggilan boleh dilakukan, atau
+	// mengembalikan ctx.Err() jika ctx batal lebih dulu.
+	Wait(ctx context.Context) error
+}
+
+// sleep menunggu d menurut clk. Nilai false berarti ctx batal lebih dulu.
+func sleep(ctx context.Context, clk clock.Clock, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	// Timer dihentikan agar tidak tertinggal jika ctx batal lebih dulu.
+	t := clk.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C():
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// ========== TOKEN BUCKET ==========
+
+// TokenBucket mengisi satu token setiap interval sampai paling banyak burst
+// token. Setiap pemanggilan memakai satu token, sehingga ledakan sampai
+// burst pemanggilan diizinkan setelah limiter menganggur.
+type TokenBucket struct {
+	clk      clock.Clock
+	interval time.Duration
+	burst    int
+
+	mu     sync.Mutex
+	tokens float64 // negatif berarti token sudah dipesan oleh Wait
+	last   time.Time
+}
+
+// NewTokenBucket membuat TokenBucket yang penuh. burst < 1 dianggap 1.
+func NewTokenBucket(clk clock.Clock, interval time.Duration, burst int) *TokenBucket {
+	burst = max(burst, 1)
+	return &TokenBucket{clk: clk, interval: interval, burst: burst, tokens: float64(burst), last: clk.Now()}
+}
+
+// refill menambah token sesuai waktu yang berlalu. Dipanggil sambil
+// memegang b.mu.
+func (b *TokenBucket) refill(now time.Time) {
+	if b.interval > 0 {
+		b.tokens += float64(now.Sub(b.last)) / float64(b.interval)
+	} else {
+		b.tokens = float64(b.burst)
+	}
+	b.tokens = min(b.tokens, float64(b.burst))
+	b.last = now
+}
+
+// Allow memakai satu token jika tersedia.
+func (b *TokenBucket) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(b.clk.Now())
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// Wait memesan satu token lalu menunggu sampai token itu terisi. Token
+// dikembalikan jika ctx batal sebelum waktunya.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	b.refill(b.clk.Now())
+	b.tokens--
+	wait := time.Duration(0)
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens * float64(b.interval))
+	}
+	b.mu.Unlock()
+
+	if !sleep(ctx, b.clk, wait) {
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+	return nil
+}
+
+// ========== LEAKY BUCKET ==========
+
+// LeakyBucket meloloskan pemanggilan dengan jarak tetap interval, tanpa
+// ledakan. Pemanggilan yang datang lebih cepat menunggu di antrean yang
+// panjangnya paling banyak capacity.
+type LeakyBucket struct {
+	clk      clock.Clock
+	interval time.Duration
+	capacity int
+
+	mu   sync.Mutex
+	next time.Time // waktu paling awal untuk pemanggilan berikutnya
+}
+
+// NewLeakyBucket membuat LeakyBucket. capacity < 0 dianggap 0, yaitu tidak
+// ada yang boleh menunggu.
+func NewLeakyBucket(clk clock.Clock, interval time.Duration, capacity int) *LeakyBucket {
+	return &LeakyBucket{clk: clk, interval: interval, capacity: max(capacity, 0)}
+}
+
+// Allow meloloskan pemanggilan jika tidak ada yang sedang mengantre dan
+// jarak dari pemanggilan sebelumnya sudah cukup.
+func (b *LeakyBucket) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.clk.Now()
+	if now.Before(b.next) {
+		return false
+	}
+	b.next = now.Add(b.interval)
+	return true
+}
+
+// Wait mengantre sampai giliran pemanggilan ini tiba, atau gagal dengan
+// ErrQueueFull jika sudah ada capacity pemanggilan yang menunggu.
+func (b *LeakyBucket) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	now := b.clk.Now()
+	slot := b.next
+	if slot.Before(now) {
+		slot = now
+	}
+	// Jumlah pemanggilan yang menunggu, termasuk yang ini.
+	if b.interval > 0 {
+		queued := int((slot.Sub(now) + b.interval - 1) / b.interval)
+		if queued > b.capacity {
+			b.mu.Unlock()
+			return ErrQueueFull
+		}
+	}
+	b.next = slot.Add(b.interval)
+	b.mu.Unlock()
+
+	if !sleep(ctx, b.clk, slot.Sub(now)) {
+		// Giliran hanya bisa dikembalikan jika belum ada yang mengantre
+		// di belakangnya.
+		b.mu.Lock()
+		if b.next.Equal(slot.Add(b.interval)) {
+			b.next = slot
+		}
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+	return nil
+}
+
+// ========== SLIDING WINDOW LOG ==========
+
+// SlidingWindow mengizinkan paling banyak limit pemanggilan dalam setiap
+// rentang window, dengan mencatat waktu setiap pemanggilan yang diloloskan.
+type SlidingWindow struct {
+	clk    clock.Clock
+	window time.Duration
+	limit  int
+
+	mu  sync.Mutex
+	log []time.Time // terurut dari yang paling lama
+}
+
+// NewSlidingWindow membuat SlidingWindow. limit < 1 dianggap 1.
+func NewSlidingWindow(clk clock.Clock, window time.Duration, limit int) *SlidingWindow {
+	return &SlidingWindow{clk: clk, window: window, limit: max(limit, 1)}
+}
+
+// reserve mencatat pemanggilan jika masih ada tempat di window. Jika tidak,
+// reserve mengembalikan lama waktu sampai catatan tertua keluar dari window.
+func (w *SlidingWindow) reserve() (ok bool, retryAfter time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	now := w.clk.Now()
+	cutoff := now.Add(-w.window)
+	i := 0
+	for i < len(w.log) && !w.log[i].After(cutoff) {
+		i++
+	}
+	w.log = w.log[i:]
+	if len(w.log) < w.limit {
+		w.log = append(w.log, now)
+		return true, 0
+	}
+	return false, w.log[0].Sub(cutoff)
+}
+
+// Allow mencatat pemanggilan jika window belum penuh.
+func (w *SlidingWindow) Allow() bool {
+	ok, _ := w.reserve()
+	return ok
+}
+
+// Wait menunggu sampai window punya tempat lalu mencatat pemanggilan.
+func (w *SlidingWindow) Wait(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		ok, retryAfter := w.reserve()
+		if ok {
+			return nil
+		}
+		if !sleep(ctx, w.clk, retryAfter) {
+			return ctx.Err()
+		}
+	}
+}
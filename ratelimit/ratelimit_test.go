@@ -0,0 +1,166 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"learn-go/clock"
+)
+
+var start = time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
+
+// allowed mengembalikan hasil n kali Allow.
+func allowed(l Limiter, n int) []bool {
+	got := make([]bool, n)
+	for i := range got {
+		got[i] = l.Allow()
+	}
+	return got
+}
+
+func TestAllow(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		new  func(clock.Clock) Limiter
+		// hasil Allow berturut-turut di awal, setelah maju 1 detik, dan
+		// setelah maju 10 detik
+		first, after1s, after10s []bool
+	}{
+		{
+			"token bucket",
+			func(c clock.Clock) Limiter { return NewTokenBucket(c, time.Second, 3) },
+			[]bool{true, true, true, false},
+			[]bool{true, false},
+			[]bool{true, true, true, false},
+		},
+		{
+			"leaky bucket",
+			func(c clock.Clock) Limiter { return NewLeakyBucket(c, time.Second, 3) },
+			[]bool{true, false},
+			[]bool{true, false},
+			[]bool{true, false},
+		},
+		{
+			"sliding window",
+			func(c clock.Clock) Limiter { return NewSlidingWindow(c, 2*time.Second, 3) },
+			[]bool{true, true, true, false},
+			[]bool{false},
+			[]bool{true, true, true, false},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			clk := clock.NewFake(start)
+			l := tt.new(clk)
+			if got := allowed(l, len(tt.first)); !slices.Equal(got, tt.first) {
+				t.Errorf("awal = %v, want %v", got, tt.first)
+			}
+			clk.Advance(time.Second)
+			if got := allowed(l, len(tt.after1s)); !slices.Equal(got, tt.after1s) {
+				t.Errorf("setelah 1s = %v, want %v", got, tt.after1s)
+			}
+			clk.Advance(10 * time.Second)
+			if got := allowed(l, len(tt.after10s)); !slices.Equal(got, tt.after10s) {
+				t.Errorf("setelah 10s = %v, want %v", got, tt.after10s)
+			}
+		})
+	}
+}
+
+// waitAsync menjalankan l.Wait di goroutine dan mengembalikan channel
+// hasilnya.
+func waitAsync(ctx context.Context, l Limiter) <-chan error {
+	done := make(chan error, 1)
+	go func() { done <- l.Wait(ctx) }()
+	return done
+}
+
+func TestTokenBucketWait(t *testing.T) {
+	clk := clock.NewFake(start)
+	b := NewTokenBucket(clk, time.Second, 1)
+	ctx := context.Background()
+
+	if err := b.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+	done := waitAsync(ctx, b)
+	clk.BlockUntil(1)
+	clk.Advance(999 * time.Millisecond)
+	select {
+	case err := <-done:
+		t.Fatalf("Wait selesai sebelum token terisi: %v", err)
+	default:
+	}
+	clk.Advance(time.Millisecond)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitCancelReturnsToken(t *testing.T) {
+	clk := clock.NewFake(start)
+	b := NewTokenBucket(clk, time.Second, 1)
+	b.Allow()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := waitAsync(ctx, b)
+	clk.BlockUntil(1)
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Wait = %v, want context.Canceled", err)
+	}
+
+	// Token yang dipesan sudah dikembalikan, jadi satu token tersedia lagi
+	// setelah satu interval.
+	clk.Advance(time.Second)
+	if !b.Allow() {
+		t.Error("token tidak dikembalikan setelah Wait batal")
+	}
+}
+
+func TestLeakyBucketQueue(t *testing.T) {
+	clk := clock.NewFake(start)
+	b := NewLeakyBucket(clk, time.Second, 2)
+	ctx := context.Background()
+
+	if err := b.Wait(ctx); err != nil { // langsung lolos
+		t.Fatal(err)
+	}
+	done := make(chan error, 2)
+	for range 2 {
+		go func() { done <- b.Wait(ctx) }()
+	}
+	clk.BlockUntil(2)
+	if err := b.Wait(ctx); !errors.Is(err, ErrQueueFull) {
+		t.Errorf("Wait dengan antrean penuh = %v, want ErrQueueFull", err)
+	}
+
+	// Antrean lolos satu per satu setiap interval.
+	clk.Advance(time.Second)
+	<-done
+	clk.BlockUntil(1)
+	clk.Advance(time.Second)
+	<-done
+}
+
+func TestSlidingWindowWait(t *testing.T) {
+	clk := clock.NewFake(start)
+	w := NewSlidingWindow(clk, time.Minute, 2)
+	ctx := context.Background()
+
+	w.Allow()
+	clk.Advance(20 * time.Second)
+	w.Allow()
+
+	done := waitAsync(ctx, w)
+	clk.BlockUntil(1)
+	clk.Advance(40 * time.Second) // catatan pertama keluar dari window
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if w.Allow() {
+		t.Error("window seharusnya penuh lagi")
+	}
+}
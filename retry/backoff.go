@@ -0,0 +1,84 @@
+package retry
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// ========== BACKOFF ==========
+
+// Backoff menghitung jeda sebelum percobaan berikutnya. attempt adalah
+// nomor percobaan yang baru gagal (dimulai dari 1) dan prev adalah jeda
+// sebelumnya (0 untuk yang pertama).
+type Backoff interface {
+	Next(attempt int, prev time.Duration) time.Duration
+}
+
+const (
+	defaultBase = 100 * time.Millisecond
+	defaultMax  = 10 * time.Second
+)
+
+// Exponential menghasilkan jeda Base, Base*Factor, Base*Factor², ... sampai
+// paling besar Max. Dengan Jitter, jeda diacak antara 0 dan nilai itu (full
+// jitter) agar banyak klien tidak mencoba ulang bersamaan.
+type Exponential struct {
+	Base   time.Duration // 0 berarti 100ms
+	Max    time.Duration // 0 berarti 10s
+	Factor float64       // <= 1 berarti 2
+	Jitter bool
+	// Rand mengembalikan bilangan acak di [0, 1). nil berarti
+	// math/rand/v2.Float64.
+	Rand func() float64
+}
+
+// Next mengembalikan jeda untuk percobaan ke-attempt.
+func (e Exponential) Next(attempt int, _ time.Duration) time.Duration {
+	base, limit := durations(e.Base, e.Max)
+	factor := e.Factor
+	if factor <= 1 {
+		factor = 2
+	}
+	d := float64(base) * math.Pow(factor, float64(attempt-1))
+	d = math.Min(d, float64(limit))
+	if e.Jitter {
+		d *= random(e.Rand)
+	}
+	return time.Duration(d)
+}
+
+// DecorrelatedJitter menghasilkan jeda acak antara Base dan tiga kali jeda
+// sebelumnya, dibatasi Max. Jeda tumbuh seperti eksponensial tetapi lebih
+// tersebar dibanding full jitter.
+type DecorrelatedJitter struct {
+	Base time.Duration  // 0 berarti 100ms
+	Max  time.Duration  // 0 berarti 10s
+	Rand func() float64 // nil berarti math/rand/v2.Float64
+}
+
+// Next mengembalikan jeda berikutnya berdasarkan prev.
+func (j DecorrelatedJitter) Next(_ int, prev time.Duration) time.Duration {
+	base, limit := durations(j.Base, j.Max)
+	upper := max(3*prev, base)
+	d := base + time.Duration(random(j.Rand)*float64(upper-base))
+	return min(d, limit)
+}
+
+// durations mengisi nilai default Base dan Max.
+func durations(base, limit time.Duration) (time.Duration, time.Duration) {
+	if base <= 0 {
+		base = defaultBase
+	}
+	if limit <= 0 {
+		limit = defaultMax
+	}
+	return base, max(limit, base)
+}
+
+func random(fn func() float64) float64 {
+	if fn == nil {
+		return rand.Float64()
+	}
+	return fn()
+}
@@ -0,0 +1,168 @@
+// Package retry menjalankan ulang operasi yang bisa gagal sementara, dengan
+// jeda backoff di antara percobaan. Error bisa ditandai permanen agar tidak
+// dicoba lagi, dan panic di dalam operasi diubah menjadi error.
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"learn-go/apperr"
+	"learn-go/clock"
+)
+
+// ErrExhausted dibungkus error dari Do jika batas percobaan atau batas
+// waktu habis sebelum operasi berhasil.
+var ErrExhausted = errors.New("retry: percobaan habis")
+
+// Policy mengatur Do. Nilai nol siap dipakai: 3 percobaan dengan backoff
+// eksponensial default dan jam sistem.
+type Policy struct {
+	// MaxAttempts adalah jumlah percobaan paling banyak, termasuk yang
+	// pertama. <= 0 berarti 3, kecuali MaxElapsed diisi: maka tanpa batas
+	// jumlah.
+	MaxAttempts int
+	// MaxElapsed membatasi total waktu sejak percobaan pertama. Do tidak
+	// menunggu jika jeda berikutnya melewati batas ini. 0 berarti tanpa
+	// batas.
+	MaxElapsed time.Duration
+	// Backoff menentukan jeda antar percobaan. nil berarti Exponential{}.
+	Backoff Backoff
+	// Retryable memutuskan apakah error boleh dicoba lagi. nil berarti
+	// semua error kecuali error permanen dan error context.
+	Retryable func(err error) bool
+	// Clock adalah sumber waktu. nil berarti clock.Real.
+	Clock clock.Clock
+}
+
+// Attempt mencatat satu percobaan.
+type Attempt struct {
+	Number   int           // dimulai dari 1
+	Err      error         // nil jika berhasil
+	Duration time.Duration // lama operasi berjalan
+	Delay    time.Duration // jeda sebelum percobaan berikutnya, 0 jika tidak ada
+}
+
+// Do memanggil fn sampai berhasil, sampai error-nya tidak boleh dicoba
+// lagi, atau sampai batas Policy habis, lalu mengembalikan riwayat semua
+// percobaan.
+//
+// Error yang dikembalikan:
+//   - nil jika fn akhirnya berhasil;
+//   - error dari fn apa adanya jika error itu permanen atau tidak
+//     retryable (pembungkus Permanent dilepas);
+//   - ErrExhausted yang membungkus error terakhir jika batas habis;
+//   - ctx.Err() yang membungkus error terakhir jika ctx batal saat menunggu.
+func Do(ctx context.Context, p Policy, fn func(ctx context.Context) error) ([]Attempt, error) {
+	clk := p.Clock
+	if clk == nil {
+		clk = clock.Real
+	}
+	backoff := p.Backoff
+	if backoff == nil {
+		backoff = Exponential{}
+	}
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = defaultRetryable
+	}
+	maxAttempts := p.MaxAttempts
+	if maxAttempts <= 0 && p.MaxElapsed <= 0 {
+		maxAttempts = 3
+	}
+
+	var history []Attempt
+	start := clk.Now()
+	delay := time.Duration(0)
+	for n := 1; ; n++ {
+		if err := ctx.Err(); err != nil {
+			return history, canceled(err, history)
+		}
+
+		began := clk.Now()
+		err := call(ctx, fn)
+		history = append(history, Attempt{Number: n, Err: err, Duration: clk.Now().Sub(began)})
+		if err == nil {
+			return history, nil
+		}
+		if IsPermanent(err) || !retryable(err) {
+			return history, unwrapPermanent(err)
+		}
+		if maxAttempts > 0 && n >= maxAttempts {
+			return history, fmt.Errorf("%w (%d percobaan): %w", ErrExhausted, n, err)
+		}
+
+		delay = backoff.Next(n, delay)
+		if p.MaxElapsed > 0 && clk.Now().Add(delay).Sub(start) > p.MaxElapsed {
+			return history, fmt.Errorf("%w (%d percobaan): %w", ErrExhausted, n, err)
+		}
+		history[len(history)-1].Delay = delay
+
+		if delay > 0 {
+			t := clk.NewTimer(delay)
+			select {
+			case <-t.C():
+			case <-ctx.Done():
+				t.Stop()
+				return history, canceled(ctx.Err(), history)
+			}
+		}
+	}
+}
+
+// call memanggil fn dan mengubah panic menjadi *apperr.PanicError.
+func call(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = apperr.Recovered(r)
+		}
+	}()
+	return fn(ctx)
+}
+
+// canceled membungkus error context dengan error percobaan terakhir.
+func canceled(ctxErr error, history []Attempt) error {
+	if len(history) == 0 {
+		return ctxErr
+	}
+	last := history[len(history)-1]
+	return fmt.Errorf("retry: %w (%d percobaan): %w", ctxErr, last.Number, last.Err)
+}
+
+func defaultRetryable(err error) bool {
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// ========== KLASIFIKASI ERROR ==========
+
+// permanentError menandai error yang tidak boleh dicoba lagi.
+type permanentError struct{ err error }
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent menandai err agar Do langsung berhenti tanpa mencoba lagi.
+// Do mengembalikan err tanpa pembungkus ini. Permanent(nil) adalah nil.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent melaporkan apakah err, atau error yang dibungkusnya, ditandai
+// dengan Permanent.
+func IsPermanent(err error) bool {
+	var p *permanentError
+	return errors.As(err, &p)
+}
+
+// unwrapPermanent melepas pembungkus Permanent paling luar.
+func unwrapPermanent(err error) error {
+	if p, ok := err.(*permanentError); ok {
+		return p.err
+	}
+	return err
+}
@@ -0,0 +1,186 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"learn-go/apperr"
+	"learn-go/clock"
+)
+
+var errFlaky = errors.New("gagal sementara")
+
+type result struct {
+	history []Attempt
+	err     error
+}
+
+// doAsync menjalankan Do di goroutine dengan clk dan backoff 1s, 2s, 4s, ...
+func doAsync(ctx context.Context, clk *clock.Fake, p Policy, fn func(context.Context) error) <-chan result {
+	p.Clock = clk
+	p.Backoff = Exponential{Base: time.Second, Max: time.Minute}
+	done := make(chan result, 1)
+	go func() {
+		h, err := Do(ctx, p, fn)
+		done <- result{h, err}
+	}()
+	return done
+}
+
+// failTimes mengembalikan operasi yang gagal dengan err sebanyak n kali
+// lalu berhasil.
+func failTimes(n int, err error) (fn func(context.Context) error, calls *int) {
+	calls = new(int)
+	return func(context.Context) error {
+		*calls++
+		if *calls <= n {
+			return err
+		}
+		return nil
+	}, calls
+}
+
+func newFake() *clock.Fake {
+	return clock.NewFake(time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC))
+}
+
+func TestDoSucceedsAfterRetries(t *testing.T) {
+	clk := newFake()
+	fn, calls := failTimes(2, errFlaky)
+	done := doAsync(context.Background(), clk, Policy{MaxAttempts: 5}, fn)
+
+	// Jeda harus tepat 1s lalu 2s.
+	for _, d := range []time.Duration{time.Second, 2 * time.Second} {
+		clk.BlockUntil(1)
+		clk.Advance(d - time.Nanosecond)
+		if clk.Waiters() != 1 {
+			t.Fatalf("jeda selesai sebelum %v", d)
+		}
+		clk.Advance(time.Nanosecond)
+	}
+	r := <-done
+	if r.err != nil || *calls != 3 {
+		t.Fatalf("Do = %v setelah %d panggilan", r.err, *calls)
+	}
+	want := []Attempt{
+		{Number: 1, Err: errFlaky, Delay: time.Second},
+		{Number: 2, Err: errFlaky, Delay: 2 * time.Second},
+		{Number: 3},
+	}
+	if len(r.history) != len(want) {
+		t.Fatalf("history = %+v", r.history)
+	}
+	for i, a := range r.history {
+		if a != want[i] {
+			t.Errorf("history[%d] = %+v, want %+v", i, a, want[i])
+		}
+	}
+}
+
+func TestDoStops(t *testing.T) {
+	errBad := errors.New("input salah")
+	for _, tt := range []struct {
+		name     string
+		policy   Policy
+		err      error
+		advance  []time.Duration // jeda yang harus dilewati
+		attempts int
+		is       []error // target errors.Is untuk error dari Do
+	}{
+		{"permanen", Policy{MaxAttempts: 5}, Permanent(errBad), nil, 1, []error{errBad}},
+		{"tidak retryable", Policy{MaxAttempts: 5, Retryable: func(err error) bool { return !errors.Is(err, errBad) }}, errBad, nil, 1, []error{errBad}},
+		{"MaxAttempts", Policy{MaxAttempts: 3}, errFlaky, []time.Duration{time.Second, 2 * time.Second}, 3, []error{ErrExhausted, errFlaky}},
+		// Percobaan ke-3 terjadi di detik ke-3; jeda 4s berikutnya
+		// melewati batas 5s sehingga Do berhenti tanpa menunggu.
+		{"MaxElapsed", Policy{MaxElapsed: 5 * time.Second}, errFlaky, []time.Duration{time.Second, 2 * time.Second}, 3, []error{ErrExhausted, errFlaky}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			clk := newFake()
+			fn, calls := failTimes(100, tt.err)
+			done := doAsync(context.Background(), clk, tt.policy, fn)
+			for _, d := range tt.advance {
+				clk.BlockUntil(1)
+				clk.Advance(d)
+			}
+			r := <-done
+			if *calls != tt.attempts || len(r.history) != tt.attempts {
+				t.Errorf("%d panggilan, history %d, want %d", *calls, len(r.history), tt.attempts)
+			}
+			for _, target := range tt.is {
+				if !errors.Is(r.err, target) {
+					t.Errorf("Do = %v, want errors.Is %v", r.err, target)
+				}
+			}
+			if IsPermanent(r.err) {
+				t.Errorf("Do mengembalikan pembungkus Permanent: %#v", r.err)
+			}
+		})
+	}
+}
+
+func TestDoCancelWhileWaiting(t *testing.T) {
+	clk := newFake()
+	ctx, cancel := context.WithCancel(context.Background())
+	fn, calls := failTimes(100, errFlaky)
+	done := doAsync(ctx, clk, Policy{MaxAttempts: 5}, fn)
+
+	clk.BlockUntil(1)
+	cancel()
+	r := <-done
+	if !errors.Is(r.err, context.Canceled) || !errors.Is(r.err, errFlaky) {
+		t.Errorf("Do = %v, want context.Canceled dan errFlaky", r.err)
+	}
+	if *calls != 1 {
+		t.Errorf("%d panggilan setelah cancel, want 1", *calls)
+	}
+}
+
+func TestDoPanic(t *testing.T) {
+	clk := newFake()
+	calls := 0
+	done := doAsync(context.Background(), clk, Policy{}, func(context.Context) error {
+		calls++
+		if calls == 1 {
+			panic("meledak")
+		}
+		return nil
+	})
+	clk.BlockUntil(1)
+	clk.Advance(time.Second)
+	r := <-done
+	if r.err != nil {
+		t.Fatal(r.err)
+	}
+	var pe *apperr.PanicError
+	if !errors.As(r.history[0].Err, &pe) || pe.Value != "meledak" || len(pe.Stack) == 0 {
+		t.Errorf("history[0].Err = %#v, want PanicError dengan stack", r.history[0].Err)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	half := func() float64 { return 0.5 }
+	for _, tt := range []struct {
+		name    string
+		backoff Backoff
+		want    []float64 // detik
+	}{
+		{"exponential", Exponential{Base: time.Second, Max: 5 * time.Second}, []float64{1, 2, 4, 5, 5}},
+		{"faktor 3", Exponential{Base: time.Second, Max: time.Minute, Factor: 3}, []float64{1, 3, 9, 27, 60}},
+		{"full jitter", Exponential{Base: 2 * time.Second, Max: time.Minute, Jitter: true, Rand: half}, []float64{1, 2, 4, 8, 16}},
+		// base + 0.5*(3*prev - base): 1, 2, 3.5, 5.75 lalu dibatasi 8
+		{"decorrelated", DecorrelatedJitter{Base: time.Second, Max: 8 * time.Second, Rand: half}, []float64{1, 2, 3.5, 5.75, 8}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			prev := time.Duration(0)
+			for i, w := range tt.want {
+				got := tt.backoff.Next(i+1, prev)
+				if want := time.Duration(w * float64(time.Second)); got != want {
+					t.Errorf("Next(%d) = %v, want %v", i+1, got, want)
+				}
+				prev = got
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+	"syscall"
+	"time"
+
+	"learn-go/metrics"
+	"learn-go/playground"
+)
+
+// shutdownTimeout adalah batas waktu menunggu request yang sedang berjalan
+// saat server dihentikan.
+const shutdownTimeout = 5 * time.Second
+
+func (a *app) runServe(args []string) int {
+	fs := a.newFlagSet("serve", func(fs *flag.FlagSet) {
+		fmt.Fprintln(fs.Output(), a.msg.T("cli.serve.usage"))
+		fs.PrintDefaults()
+	})
+	addr := fs.String("addr", "127.0.0.1:8080", a.msg.T("cli.flag.addr"))
+	if code, ok := a.parse(fs, args); !ok {
+		return code
+	}
+	if fs.NArg() != 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		fmt.Fprintf(a.stderr, "learn-go serve: %v\n", err)
+		return exitFail
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return a.serve(ctx, ln)
+}
+
+// serve menjalankan playground di ln sampai ctx dibatalkan, lalu menunggu
+// request yang sedang berjalan selesai.
+func (a *app) serve(ctx context.Context, ln net.Listener) int {
+	srv := &http.Server{
+		Handler:           a.serveHandler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	fmt.Fprint(a.stdout, a.msg.Sprintf("cli.serve.listening", ln.Addr(), ln.Addr(), ln.Addr()))
+
+	errc := make(chan error, 1)
+	go func() { errc <- srv.Serve(ln) }()
+
+	select {
+	case err := <-errc:
+		fmt.Fprintf(a.stderr, "learn-go serve: %v\n", err)
+		return exitFail
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		fmt.Fprintf(a.stderr, "learn-go serve: %v\n", err)
+		return exitFail
+	}
+	if err := <-errc; !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(a.stderr, "learn-go serve: %v\n", err)
+		return exitFail
+	}
+	fmt.Fprintln(a.stdout, a.msg.T("cli.serve.stopped"))
+	return exitOK
+}
+
+// serveHandler memasang playground beserta metriknya: setiap request
+// dihitung dan diukur lamanya, dan hasilnya tersedia di GET /metrics dalam
+// format teks Prometheus.
+func (a *app) serveHandler() http.Handler {
+	reg := metrics.NewRegistry()
+	requests := reg.ShardedCounter("playground_requests_total", a.msg.T("cli.serve.help_requests"), 0)
+	failures := reg.Counter("playground_request_errors_total", a.msg.T("cli.serve.help_errors"))
+	inFlight := reg.Gauge("playground_requests_in_flight", a.msg.T("cli.serve.help_in_flight"))
+	latency := reg.Histogram("playground_request_duration_seconds", a.msg.T("cli.serve.help_duration"), nil)
+	reg.GaugeFunc("go_goroutines", a.msg.T("cli.serve.help_goroutines"), func() float64 {
+		return float64(runtime.NumGoroutine())
+	})
+
+	play := playground.NewHandler(a.msg.Locale())
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", reg.Handler())
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		inFlight.Inc()
+		defer inFlight.Dec()
+		start := a.clock.Now()
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		play.ServeHTTP(sw, r)
+		requests.Inc()
+		if sw.status >= 400 {
+			failures.Inc()
+		}
+		latency.Observe(a.clock.Since(start).Seconds())
+	})
+	return mux
+}
+
+// statusWriter mencatat status code yang ditulis handler.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
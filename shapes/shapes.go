@@ -0,0 +1,85 @@
+// Package shapes berisi interface Shape beserta implementasinya untuk
+// mendemonstrasikan polymorphism di Go.
+package shapes
+
+import (
+	"fmt"
+	"math"
+)
+
+// ========== INTERFACE ==========
+
+// Shape adalah bangun datar yang bisa dihitung luas dan kelilingnya.
+type Shape interface {
+	Area() float64
+	Perimeter() float64
+	GetType() string
+}
+
+// Rectangle adalah persegi panjang.
+type Rectangle struct {
+	Width, Height float64
+}
+
+func (r Rectangle) Area() float64 {
+	return r.Width * r.Height
+}
+
+func (r Rectangle) Perimeter() float64 {
+	return 2 * (r.Width + r.Height)
+}
+
+func (r Rectangle) GetType() string {
+	return "Rectangle"
+}
+
+// Circle adalah lingkaran.
+type Circle struct {
+	Radius float64
+}
+
+func (c Circle) Area() float64 {
+	return math.Pi * c.Radius * c.Radius
+}
+
+func (c Circle) Perimeter() float64 {
+	return 2 * math.Pi * c.Radius
+}
+
+func (c Circle) GetType() string {
+	return "Circle"
+}
+
+// Triangle adalah segitiga dengan alas, tinggi dan dua sisi lainnya.
+type Triangle struct {
+	Base, Height, Side1, Side2 float64
+}
+
+func (t Triangle) Area() float64 {
+	return 0.5 * t.Base * t.Height
+}
+
+func (t Triangle) Perimeter() float64 {
+	return t.Base + t.Side1 + t.Side2
+}
+
+func (t Triangle) GetType() string {
+	return "Triangle"
+}
+
+// ========== FUNGSI YANG BEKERJA DENGAN INTERFACE ==========
+
+// Describe mengembalikan ringkasan jenis, luas dan keliling s.
+func Describe(s Shape) string {
+	return fmt.Sprintf("%s - Area: %.2f, Perimeter: %.2f",
+		s.GetType(), s.Area(), s.Perimeter())
+}
+
+// TotalArea menghitung total luas dari semua shapes.
+func TotalArea(shapes []Shape) float64 {
+	total := 0.0
+	for _, shape := range shapes {
+		total += shape.Area()
+	}
+	return total
+}
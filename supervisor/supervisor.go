@@ -0,0 +1,311 @@
+// Package supervisor menjalankan goroutine "anak" dan menyalakannya ulang
+// jika berhenti atau panic, meniru supervisor di Erlang/OTP. Panic di
+// dalam anak tidak menghentikan program; panic diubah menjadi
+// *apperr.PanicError lengkap dengan stack trace.
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"learn-go/apperr"
+	"learn-go/clock"
+)
+
+// ErrTooManyRestarts dibungkus error dari Run jika anak dinyalakan ulang
+// lebih dari Config.MaxRestarts kali dalam Config.Period.
+var ErrTooManyRestarts = errors.New("supervisor: terlalu banyak restart")
+
+// Strategy menentukan anak mana yang dinyalakan ulang ketika satu anak
+// berhenti.
+type Strategy int
+
+const (
+	// OneForOne hanya menyalakan ulang anak yang berhenti.
+	OneForOne Strategy = iota
+	// OneForAll menghentikan semua anak lalu menyalakan ulang semuanya,
+	// untuk anak yang saling bergantung. Anak Temporary hanya dihentikan
+	// dan tidak dinyalakan ulang, seperti di Erlang/OTP.
+	OneForAll
+)
+
+// Restart menentukan kapan seorang anak dinyalakan ulang.
+type Restart int
+
+const (
+	// Permanent selalu dinyalakan ulang, juga jika berhenti tanpa error.
+	Permanent Restart = iota
+	// Transient hanya dinyalakan ulang jika berhenti dengan error atau
+	// panic.
+	Transient
+	// Temporary tidak pernah dinyalakan ulang.
+	Temporary
+)
+
+// Child adalah goroutine yang diawasi. Run harus berhenti ketika ctx
+// batal.
+type Child struct {
+	Name    string
+	Run     func(ctx context.Context) error
+	Restart Restart
+}
+
+// Config mengatur Supervisor. Nilai nol siap dipakai: OneForOne, paling
+// banyak 3 restart dalam 5 detik.
+type Config struct {
+	Strategy Strategy
+	// MaxRestarts dan Period adalah intensitas restart: jika restart
+	// melebihi MaxRestarts dalam Period, supervisor menyerah agar anak
+	// yang terus gagal tidak berputar tanpa henti.
+	MaxRestarts int
+	Period      time.Duration
+	// OnExit dipanggil setiap kali anak berhenti, dengan err berisi error
+	// atau *apperr.PanicError dan restart true jika anak akan dinyalakan ulang.
+	OnExit func(child string, err error, restart bool)
+	// Clock dipakai untuk menghitung intensitas restart. nil berarti
+	// clock.Real.
+	Clock clock.Clock
+}
+
+// ChildState adalah keadaan seorang anak.
+type ChildState int
+
+const (
+	Idle    ChildState = iota // belum dijalankan
+	Running                   // sedang berjalan
+	Stopped                   // berhenti tanpa error dan tidak dinyalakan ulang
+	Failed                    // berhenti dengan error dan tidak dinyalakan ulang
+)
+
+func (s ChildState) String() string {
+	switch s {
+	case Idle:
+		return "idle"
+	case Running:
+		return "running"
+	case Stopped:
+		return "stopped"
+	case Failed:
+		return "failed"
+	}
+	return "unknown"
+}
+
+// Status adalah keadaan seorang anak saat ini.
+type Status struct {
+	Name     string
+	State    ChildState
+	Restarts int
+	LastErr  error     // error atau *apperr.PanicError terakhir, nil jika belum pernah gagal
+	Since    time.Time // waktu State terakhir berubah
+}
+
+// Supervisor mengawasi sekumpulan anak. Status aman dipanggil dari
+// goroutine lain selama Run berjalan.
+type Supervisor struct {
+	cfg      Config
+	children []Child
+
+	mu     sync.Mutex
+	status []Status
+
+	// Hanya dipakai oleh goroutine Run.
+	cancels  []context.CancelFunc
+	running  int         // jumlah anak yang goroutine-nya belum selesai
+	restarts []time.Time // waktu restart dalam Period terakhir
+	exits    chan exit
+}
+
+type exit struct {
+	index int
+	err   error
+}
+
+// New membuat Supervisor untuk children. Anak baru berjalan setelah Run
+// dipanggil.
+func New(cfg Config, children ...Child) *Supervisor {
+	if cfg.MaxRestarts <= 0 {
+		cfg.MaxRestarts = 3
+	}
+	if cfg.Period <= 0 {
+		cfg.Period = 5 * time.Second
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = clock.Real
+	}
+	s := &Supervisor{
+		cfg:      cfg,
+		children: children,
+		status:   make([]Status, len(children)),
+		cancels:  make([]context.CancelFunc, len(children)),
+	}
+	for i, c := range children {
+		s.status[i] = Status{Name: c.Name, State: Idle}
+	}
+	return s
+}
+
+// Run menjalankan semua anak dan mengawasinya sampai ctx batal, sampai
+// tidak ada lagi anak yang berjalan, atau sampai intensitas restart
+// terlampaui. Sebelum kembali, Run menghentikan semua anak dan menunggu
+// mereka selesai.
+//
+// Run mengembalikan nil jika ctx batal atau semua anak selesai, dan
+// ErrTooManyRestarts yang membungkus error anak terakhir jika menyerah.
+func (s *Supervisor) Run(ctx context.Context) error {
+	s.exits = make(chan exit)
+	for i := range s.children {
+		s.start(ctx, i)
+	}
+	defer s.stopAll(false)
+
+	for s.running > 0 {
+		var e exit
+		select {
+		case e = <-s.exits:
+		case <-ctx.Done():
+			return nil
+		}
+		s.running--
+		if ctx.Err() != nil {
+			s.setState(e.index, Stopped) // anak berhenti karena shutdown
+			continue
+		}
+
+		child := s.children[e.index]
+		restart := child.Restart == Permanent || (child.Restart == Transient && e.err != nil)
+		giveUp := restart && !s.allowRestart()
+		if giveUp {
+			restart = false
+		}
+		s.exited(e.index, e.err, restart)
+		if s.cfg.OnExit != nil {
+			s.cfg.OnExit(child.Name, e.err, restart)
+		}
+		if giveUp {
+			s.setState(e.index, Failed)
+			if e.err == nil {
+				return fmt.Errorf("%w: %s", ErrTooManyRestarts, child.Name)
+			}
+			return fmt.Errorf("%w: %s: %w", ErrTooManyRestarts, child.Name, e.err)
+		}
+		if !restart {
+			continue
+		}
+
+		switch s.cfg.Strategy {
+		case OneForAll:
+			s.stopAll(true)
+			for i, c := range s.children {
+				if s.state(i) != Running {
+					continue
+				}
+				if c.Restart == Temporary && i != e.index {
+					s.setState(i, Stopped)
+					continue
+				}
+				s.start(ctx, i)
+			}
+		default:
+			s.start(ctx, e.index)
+		}
+	}
+	return nil
+}
+
+// start menjalankan anak ke-i di goroutine baru.
+func (s *Supervisor) start(ctx context.Context, i int) {
+	childCtx, cancel := context.WithCancel(ctx)
+	s.cancels[i] = cancel
+	s.running++
+	s.setState(i, Running)
+
+	run := s.children[i].Run
+	go func() {
+		defer cancel()
+		s.exits <- exit{index: i, err: call(childCtx, run)}
+	}()
+}
+
+// call menjalankan run dan mengubah panic menjadi *apperr.PanicError.
+func call(ctx context.Context, run func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = apperr.Recovered(r)
+		}
+	}()
+	return run(ctx)
+}
+
+// stopAll membatalkan semua anak yang berjalan dan menunggu mereka
+// berhenti. Jika keep true, State anak tetap Running agar OneForAll tahu
+// anak mana yang harus dinyalakan lagi.
+func (s *Supervisor) stopAll(keep bool) {
+	for _, cancel := range s.cancels {
+		if cancel != nil {
+			cancel()
+		}
+	}
+	for ; s.running > 0; s.running-- {
+		e := <-s.exits
+		if !keep && s.state(e.index) == Running {
+			s.setState(e.index, Stopped)
+		}
+	}
+}
+
+// allowRestart mencatat satu restart dan melaporkan apakah intensitas
+// restart masih di bawah batas.
+func (s *Supervisor) allowRestart() bool {
+	now := s.cfg.Clock.Now()
+	cutoff := now.Add(-s.cfg.Period)
+	kept := s.restarts[:0]
+	for _, t := range s.restarts {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	s.restarts = append(kept, now)
+	return len(s.restarts) <= s.cfg.MaxRestarts
+}
+
+// exited mencatat anak ke-i yang berhenti dengan err.
+func (s *Supervisor) exited(i int, err error, restart bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := &s.status[i]
+	if err != nil {
+		st.LastErr = err
+	}
+	switch {
+	case restart:
+		st.Restarts++
+	case err != nil:
+		st.State, st.Since = Failed, s.cfg.Clock.Now()
+	default:
+		st.State, st.Since = Stopped, s.cfg.Clock.Now()
+	}
+}
+
+func (s *Supervisor) setState(i int, state ChildState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status[i].State = state
+	s.status[i].Since = s.cfg.Clock.Now()
+}
+
+func (s *Supervisor) state(i int) ChildState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.status[i].State
+}
+
+// Status mengembalikan salinan keadaan semua anak sesuai urutan New.
+func (s *Supervisor) Status() []Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Status(nil), s.status...)
+}
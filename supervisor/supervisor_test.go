@@ -0,0 +1,219 @@
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"learn-go/apperr"
+	"learn-go/clock"
+)
+
+var errDown = errors.New("anak gagal")
+
+func newFake() *clock.Fake {
+	return clock.NewFake(time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC))
+}
+
+func TestOneForOnePanic(t *testing.T) {
+	var calls atomic.Int32
+	var exits []string
+	s := New(Config{
+		OnExit: func(child string, err error, restart bool) {
+			if restart {
+				exits = append(exits, child+" restart")
+			} else {
+				exits = append(exits, child+" selesai")
+			}
+		},
+	}, Child{Name: "flaky", Restart: Transient, Run: func(context.Context) error {
+		if calls.Add(1) <= 2 {
+			panic("meledak")
+		}
+		return nil
+	}})
+
+	if err := s.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(exits, ", "); got != "flaky restart, flaky restart, flaky selesai" {
+		t.Errorf("OnExit = %s", got)
+	}
+	st := s.Status()[0]
+	var pe *apperr.PanicError
+	if st.State != Stopped || st.Restarts != 2 || !errors.As(st.LastErr, &pe) {
+		t.Fatalf("Status = %+v", st)
+	}
+	if pe.Value != "meledak" || !strings.Contains(string(pe.Stack), "supervisor_test.go") {
+		t.Errorf("PanicError = %v, stack:\n%s", pe.Value, pe.Stack)
+	}
+}
+
+func TestRestartPolicy(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		restart Restart
+		err     error
+		calls   int32
+		state   ChildState
+	}{
+		{"permanent selesai tetap dinyalakan ulang", Permanent, nil, 3, Failed},
+		{"transient selesai tidak dinyalakan ulang", Transient, nil, 1, Stopped},
+		{"transient gagal dinyalakan ulang", Transient, errDown, 3, Failed},
+		{"temporary tidak pernah dinyalakan ulang", Temporary, errDown, 1, Failed},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			s := New(Config{MaxRestarts: 2, Clock: newFake()}, Child{Name: "a", Restart: tt.restart, Run: func(context.Context) error {
+				calls.Add(1)
+				return tt.err
+			}})
+			err := s.Run(context.Background())
+			if calls.Load() != tt.calls {
+				t.Errorf("dijalankan %d kali, want %d", calls.Load(), tt.calls)
+			}
+			if giveUp := tt.calls > 1; giveUp != errors.Is(err, ErrTooManyRestarts) {
+				t.Errorf("Run = %v", err)
+			}
+			if st := s.Status()[0]; st.State != tt.state {
+				t.Errorf("State = %v, want %v", st.State, tt.state)
+			}
+		})
+	}
+}
+
+// TestRestartIntensity memastikan restart yang sudah lewat dari Period
+// tidak dihitung.
+func TestRestartIntensity(t *testing.T) {
+	clk := newFake()
+	var calls atomic.Int32
+	s := New(Config{MaxRestarts: 2, Period: time.Minute, Clock: clk}, Child{Name: "a", Restart: Transient, Run: func(context.Context) error {
+		if calls.Add(1) > 5 {
+			return nil
+		}
+		clk.Advance(31 * time.Second) // paling banyak 2 restart per menit
+		return errDown
+	}})
+	if err := s.Run(context.Background()); err != nil {
+		t.Fatalf("Run = %v setelah %d panggilan", err, calls.Load())
+	}
+
+	calls.Store(0)
+	s = New(Config{MaxRestarts: 2, Period: time.Minute, Clock: clk}, Child{Name: "a", Restart: Transient, Run: func(context.Context) error {
+		calls.Add(1)
+		clk.Advance(10 * time.Second)
+		return errDown
+	}})
+	if err := s.Run(context.Background()); !errors.Is(err, ErrTooManyRestarts) || !errors.Is(err, errDown) || calls.Load() != 3 {
+		t.Errorf("Run = %v setelah %d panggilan", err, calls.Load())
+	}
+}
+
+func TestOneForAll(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	started := make(chan string, 10)
+	var failed atomic.Bool
+	s := New(Config{Strategy: OneForAll},
+		Child{Name: "db", Run: func(ctx context.Context) error {
+			started <- "db"
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+		Child{Name: "cache", Run: func(ctx context.Context) error {
+			started <- "cache"
+			if failed.CompareAndSwap(false, true) {
+				return errDown
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+	)
+	done := make(chan error, 1)
+	go func() { done <- s.Run(ctx) }()
+
+	// Kegagalan cache menyalakan ulang db juga: 2 start awal + 2 restart.
+	count := map[string]int{}
+	for range 4 {
+		count[<-started]++
+	}
+	if count["db"] != 2 || count["cache"] != 2 {
+		t.Errorf("start = %v", count)
+	}
+	for _, st := range s.Status() {
+		if st.State != Running {
+			t.Errorf("%s: State = %v, want running", st.Name, st.State)
+		}
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	for _, st := range s.Status() {
+		if st.State != Stopped {
+			t.Errorf("%s: State = %v setelah shutdown, want stopped", st.Name, st.State)
+		}
+	}
+	if st := s.Status()[1]; st.Restarts != 1 || !errors.Is(st.LastErr, errDown) {
+		t.Errorf("cache: %+v", st)
+	}
+}
+
+// TestOneForAllTemporary memastikan anak Temporary ikut dihentikan tetapi
+// tidak dinyalakan ulang ketika saudaranya gagal.
+func TestOneForAllTemporary(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	started := make(chan string, 10)
+	var failed atomic.Bool
+	wait := func(name string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			started <- name
+			<-ctx.Done()
+			return ctx.Err()
+		}
+	}
+	s := New(Config{Strategy: OneForAll},
+		Child{Name: "db", Run: wait("db")},
+		Child{Name: "logger", Run: wait("logger"), Restart: Temporary},
+		Child{Name: "cache", Run: func(ctx context.Context) error {
+			started <- "cache"
+			if failed.CompareAndSwap(false, true) {
+				return errDown
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+	)
+	done := make(chan error, 1)
+	go func() { done <- s.Run(ctx) }()
+
+	// 3 start awal, lalu hanya db dan cache yang dinyalakan ulang.
+	count := map[string]int{}
+	for range 5 {
+		count[<-started]++
+	}
+	if count["db"] != 2 || count["cache"] != 2 || count["logger"] != 1 {
+		t.Errorf("start = %v", count)
+	}
+	want := map[string]ChildState{"db": Running, "logger": Stopped, "cache": Running}
+	for _, st := range s.Status() {
+		if st.State != want[st.Name] {
+			t.Errorf("%s: State = %v, want %v", st.Name, st.State, want[st.Name])
+		}
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case name := <-started:
+		t.Errorf("%s dinyalakan lagi", name)
+	default:
+	}
+}
@@ -0,0 +1,70 @@
+package textutil
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+// ========== CONVERSION UTILITIES ==========
+
+// FormatNumber memformat n dengan pemisah ribuan, misalnya 1234567 menjadi
+// "1,234,567".
+func FormatNumber(n int) string {
+	str := strconv.Itoa(n)
+	// Tanda minus bukan digit, jadi pisahkan dulu agar tidak ikut dikelompokkan.
+	sign := ""
+	if n < 0 {
+		sign, str = "-", str[1:]
+	}
+	if len(str) <= 3 {
+		return sign + str
+	}
+
+	var result []string
+	for i := len(str); i > 0; i -= 3 {
+		start := i - 3
+		if start < 0 {
+			start = 0
+		}
+		result = append([]string{str[start:i]}, result...)
+	}
+	return sign + strings.Join(result, ",")
+}
+
+// BytesToHuman mengubah jumlah byte menjadi format yang mudah dibaca,
+// misalnya 1536 menjadi "1.5 KB".
+func BytesToHuman(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	// int64 paling besar sekitar 8 EB, jadi EB cukup sebagai satuan terakhir.
+	units := []string{"KB", "MB", "GB", "TB", "PB", "EB"}
+	return fmt.Sprintf("%.1f %s", float64(bytes)/float64(div), units[exp])
+}
+
+const randomCharset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// GenerateRandomString membuat string acak alfanumerik sepanjang length.
+func GenerateRandomString(length int) string {
+	return RandomString(rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())), length)
+}
+
+// RandomString sama seperti GenerateRandomString tetapi memakai r sebagai
+// sumber acak, sehingga seed yang sama selalu menghasilkan string yang sama.
+func RandomString(r *rand.Rand, length int) string {
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = randomCharset[r.IntN(len(randomCharset))]
+	}
+	return string(result)
+}
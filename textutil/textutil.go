@@ -0,0 +1,57 @@
+// Package textutil berisi utilitas untuk memanipulasi dan memformat string.
+package textutil
+
+import (
+	"regexp"
+	"strings"
+)
+
+var whitespaceRe = regexp.MustCompile(`\s+`)
+
+// ========== STRING UTILITIES ==========
+
+// CleanString menghapus spasi di awal/akhir dan mengganti spasi berulang
+// dengan satu spasi.
+func CleanString(s string) string {
+	cleaned := strings.TrimSpace(s)
+	return whitespaceRe.ReplaceAllString(cleaned, " ")
+}
+
+// ToTitleCase membuat huruf pertama setiap kata menjadi kapital.
+func ToTitleCase(s string) string {
+	return strings.Title(strings.ToLower(s))
+}
+
+// Reverse membalik string berdasarkan rune sehingga aman untuk UTF-8.
+func Reverse(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
+// ReverseRecursive membalik string byte per byte secara rekursif.
+func ReverseRecursive(s string) string {
+	if len(s) <= 1 {
+		return s
+	}
+	return string(s[len(s)-1]) + ReverseRecursive(s[:len(s)-1])
+}
+
+// IsPalindrome mengecek apakah s sama jika dibaca dari belakang, tanpa
+// memperhatikan spasi dan huruf besar/kecil.
+func IsPalindrome(s string) bool {
+	cleaned := strings.ReplaceAll(strings.ToLower(s), " ", "")
+	return cleaned == Reverse(cleaned)
+}
+
+// CountWords menghitung kemunculan setiap kata (case-insensitive) dalam text.
+func CountWords(text string) map[string]int {
+	words := strings.Fields(strings.ToLower(text))
+	wordCount := make(map[string]int)
+	for _, word := range words {
+		wordCount[word]++
+	}
+	return wordCount
+}
@@ -0,0 +1,61 @@
+// Package timeutil berisi utilitas tanggal dan waktu.
+package timeutil
+
+import (
+	"fmt"
+	"time"
+
+	"learn-go/clock"
+)
+
+// FormatDate memformat t dengan salah satu pola "DD/MM/YYYY", "MM/DD/YYYY",
+// "YYYY-MM-DD" atau "DD Mon YYYY". Pola lain memakai RFC3339.
+func FormatDate(t time.Time, format string) string {
+	switch format {
+	case "DD/MM/YYYY":
+		return t.Format("02/01/2006")
+	case "MM/DD/YYYY":
+		return t.Format("01/02/2006")
+	case "YYYY-MM-DD":
+		return t.Format("2006-01-02")
+	case "DD Mon YYYY":
+		return t.Format("02 Jan 2006")
+	default:
+		return t.Format(time.RFC3339)
+	}
+}
+
+// CalculateAge menghitung umur dalam tahun dari birthDate sampai waktu
+// sekarang menurut clk.
+func CalculateAge(clk clock.Clock, birthDate time.Time) int {
+	return AgeAt(birthDate, clk.Now())
+}
+
+// AgeAt menghitung umur dalam tahun dari birthDate sampai now.
+func AgeAt(birthDate, now time.Time) int {
+	age := now.Year() - birthDate.Year()
+
+	if now.YearDay() < birthDate.YearDay() {
+		age--
+	}
+
+	return age
+}
+
+// TimeDifference mengembalikan selisih waktu start dan end dalam format
+// hari, jam, menit dan detik.
+func TimeDifference(start, end time.Time) string {
+	diff := end.Sub(start)
+
+	days := int(diff.Hours() / 24)
+	hours := int(diff.Hours()) % 24
+	minutes := int(diff.Minutes()) % 60
+	seconds := int(diff.Seconds()) % 60
+
+	return fmt.Sprintf("%d days, %d hours, %d minutes, %d seconds", days, hours, minutes, seconds)
+}
+
+// IsLeapYear mengecek apakah year adalah tahun kabisat.
+func IsLeapYear(year int) bool {
+	return (year%4 == 0 && year%100 != 0) || year%400 == 0
+}
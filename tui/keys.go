@@ -0,0 +1,110 @@
+package tui
+
+import "unicode/utf8"
+
+// Key adalah satu tombol yang ditekan. Tombol biasa disimpan di Rune
+// dengan Code KeyRune.
+type Key struct {
+	Code KeyCode
+	Rune rune
+}
+
+// KeyCode membedakan tombol khusus dari karakter biasa.
+type KeyCode int
+
+const (
+	KeyRune KeyCode = iota
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyPageUp
+	KeyPageDown
+	KeyHome
+	KeyEnd
+	KeyEnter
+	KeyTab
+	KeyBackTab
+	KeyEsc
+	KeyCtrlC
+)
+
+// escapes memetakan escape sequence terminal ke tombol. Home dan End punya
+// beberapa bentuk tergantung emulator terminal.
+var escapes = map[string]KeyCode{
+	"\x1b[A":  KeyUp,
+	"\x1bOA":  KeyUp,
+	"\x1b[B":  KeyDown,
+	"\x1bOB":  KeyDown,
+	"\x1b[C":  KeyRight,
+	"\x1bOC":  KeyRight,
+	"\x1b[D":  KeyLeft,
+	"\x1bOD":  KeyLeft,
+	"\x1b[5~": KeyPageUp,
+	"\x1b[6~": KeyPageDown,
+	"\x1b[H":  KeyHome,
+	"\x1bOH":  KeyHome,
+	"\x1b[1~": KeyHome,
+	"\x1b[7~": KeyHome,
+	"\x1b[F":  KeyEnd,
+	"\x1bOF":  KeyEnd,
+	"\x1b[4~": KeyEnd,
+	"\x1b[8~": KeyEnd,
+	"\x1b[Z":  KeyBackTab,
+}
+
+// parseKeys mengubah byte hasil satu kali baca dari terminal menjadi
+// daftar tombol. Escape sequence yang tidak dikenal diabaikan, dan ESC yang
+// berdiri sendiri menjadi KeyEsc.
+func parseKeys(b []byte) []Key {
+	var keys []Key
+	for len(b) > 0 {
+		switch c := b[0]; {
+		case c == 0x1b:
+			n := escapeLen(b)
+			if n == 1 {
+				keys = append(keys, Key{Code: KeyEsc})
+			} else if code, ok := escapes[string(b[:n])]; ok {
+				keys = append(keys, Key{Code: code})
+			}
+			b = b[n:]
+			continue
+		case c == '\r' || c == '\n':
+			keys = append(keys, Key{Code: KeyEnter})
+		case c == '\t':
+			keys = append(keys, Key{Code: KeyTab})
+		case c == 0x03:
+			keys = append(keys, Key{Code: KeyCtrlC})
+		case c < 0x20 || c == 0x7f:
+			// Tombol kontrol lain tidak dipakai.
+		default:
+			r, size := utf8.DecodeRune(b)
+			keys = append(keys, Key{Code: KeyRune, Rune: r})
+			b = b[size:]
+			continue
+		}
+		b = b[1:]
+	}
+	return keys
+}
+
+// escapeLen mengembalikan panjang escape sequence di awal b: ESC [ diikuti
+// parameter dan satu byte akhir, ESC O diikuti satu byte, atau ESC saja.
+func escapeLen(b []byte) int {
+	if len(b) < 2 {
+		return 1
+	}
+	switch b[1] {
+	case 'O':
+		return min(3, len(b))
+	case '[':
+		for i := 2; i < len(b); i++ {
+			if b[i] >= 0x40 && b[i] <= 0x7e {
+				return i + 1
+			}
+		}
+		return len(b)
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,34 @@
+package tui
+
+import "learn-go/i18n"
+
+func init() {
+	i18n.Register(i18n.Indonesian, map[string]string{
+		"tui.demos":        "Kategori",
+		"tui.sections":     "Section",
+		"tui.all_sections": "Semua section",
+		"tui.output":       "Keluaran",
+		"tui.source":       "Sumber",
+		"tui.no_output":    "Tekan Enter untuk menjalankan demo.",
+		"tui.no_source":    "Kode sumber tidak tersedia.",
+		"tui.running":      "Menjalankan %s...",
+		"tui.busy":         "Demo masih berjalan.",
+		"tui.no_section":   "Section %d tidak ada.",
+		"tui.too_small":    "Terminal terlalu kecil.",
+		"tui.help":         "↑↓ pilih  ←→/Tab panel  Enter jalankan  0-9 section  r ulangi  PgUp/PgDn gulung  [ ] fungsi  s sumber  q keluar",
+	})
+	i18n.Register(i18n.English, map[string]string{
+		"tui.demos":        "Categories",
+		"tui.sections":     "Sections",
+		"tui.all_sections": "All sections",
+		"tui.output":       "Output",
+		"tui.source":       "Source",
+		"tui.no_output":    "Press Enter to run the demo.",
+		"tui.no_source":    "No source available.",
+		"tui.running":      "Running %s...",
+		"tui.busy":         "A demo is still running.",
+		"tui.no_section":   "There is no section %d.",
+		"tui.too_small":    "Terminal too small.",
+		"tui.help":         "↑↓ select  ←→/Tab pane  Enter run  0-9 section  r rerun  PgUp/PgDn scroll  [ ] function  s source  q quit",
+	})
+}
@@ -0,0 +1,298 @@
+package tui
+
+import (
+	"bytes"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"learn-go/demo"
+	"learn-go/i18n"
+)
+
+// pane adalah panel yang sedang menerima input keyboard.
+type pane int
+
+const (
+	paneDemos pane = iota
+	paneSections
+	paneOutput
+	paneSource
+)
+
+// model menyimpan seluruh state tampilan. Semua method dipanggil dari satu
+// goroutine; hanya job yang berjalan di goroutine lain.
+type model struct {
+	cfg   Config
+	msg   *i18n.Printer
+	demos []demo.Demo
+
+	demo       int // indeks demo terpilih
+	section    int // 0 berarti semua section
+	focus      pane
+	showSource bool
+	width      int
+	height     int
+
+	job       *job // run yang sedang berjalan, nil jika tidak ada
+	last      *job // run terakhir yang sudah selesai atau sedang berjalan
+	output    []string
+	outScroll int
+	follow    bool // ikuti baris terakhir selama demo berjalan
+
+	funcs     []Source // fungsi yang dipanggil oleh run terakhir
+	fn        int
+	srcScroll int
+
+	status string // pesan sementara di baris bawah
+}
+
+func newModel(cfg Config) *model {
+	return &model{
+		cfg:        cfg,
+		msg:        i18n.NewPrinter(cfg.Locale),
+		demos:      demo.All(),
+		showSource: true,
+		width:      80,
+		height:     24,
+	}
+}
+
+// action adalah hasil update yang harus dikerjakan oleh loop utama.
+type action int
+
+const (
+	actNone action = iota
+	actQuit
+	actStart // m.job baru saja dibuat dan harus dijalankan
+)
+
+// update memproses satu tombol.
+func (m *model) update(k Key) action {
+	m.status = ""
+	switch k.Code {
+	case KeyCtrlC:
+		return actQuit
+	case KeyTab, KeyRight:
+		m.moveFocus(1)
+	case KeyBackTab, KeyLeft:
+		m.moveFocus(-1)
+	case KeyUp:
+		m.move(-1)
+	case KeyDown:
+		m.move(1)
+	case KeyPageUp:
+		m.scroll(-m.page())
+	case KeyPageDown:
+		m.scroll(m.page())
+	case KeyHome:
+		m.scroll(-1 << 30)
+	case KeyEnd:
+		m.scroll(1 << 30)
+	case KeyEnter:
+		return m.start(m.demo, m.section)
+	case KeyRune:
+		return m.updateRune(k.Rune)
+	}
+	return actNone
+}
+
+func (m *model) updateRune(r rune) action {
+	switch {
+	case r == 'q':
+		return actQuit
+	case r == 'k':
+		m.move(-1)
+	case r == 'j':
+		m.move(1)
+	case r == 'h':
+		m.moveFocus(-1)
+	case r == 'l':
+		m.moveFocus(1)
+	case r == 's':
+		m.showSource = !m.showSource
+		if !m.showSource && m.focus == paneSource {
+			m.focus = paneOutput
+		}
+	case r == '[':
+		m.selectFunc(m.fn - 1)
+	case r == ']':
+		m.selectFunc(m.fn + 1)
+	case r == 'r':
+		if m.last != nil {
+			return m.start(m.last.demo, m.last.section)
+		}
+	case r >= '0' && r <= '9':
+		n := int(r - '0')
+		if n > len(m.demos[m.demo].Sections) {
+			m.status = m.msg.Sprintf("tui.no_section", n)
+			return actNone
+		}
+		m.section = n
+		return m.start(m.demo, n)
+	}
+	return actNone
+}
+
+func (m *model) moveFocus(delta int) {
+	n := 4
+	if !m.showSource {
+		n = 3
+	}
+	m.focus = pane((int(m.focus) + delta + n) % n)
+}
+
+// move menggeser pilihan di daftar yang sedang fokus, atau menggulung
+// panel keluaran dan sumber satu baris.
+func (m *model) move(delta int) {
+	switch m.focus {
+	case paneDemos:
+		m.demo = clamp(m.demo+delta, 0, len(m.demos)-1)
+		m.section = 0
+	case paneSections:
+		m.section = clamp(m.section+delta, 0, len(m.demos[m.demo].Sections))
+	default:
+		m.scroll(delta)
+	}
+}
+
+// scroll menggulung panel sumber jika sedang fokus, dan panel keluaran
+// jika tidak.
+func (m *model) scroll(delta int) {
+	if m.focus == paneSource {
+		m.srcScroll = max(m.srcScroll+delta, 0)
+		return
+	}
+	m.outScroll = max(m.outScroll+delta, 0)
+	m.follow = false
+}
+
+// page adalah jumlah baris isi panel keluaran.
+func (m *model) page() int {
+	return max(m.height-4, 1)
+}
+
+func (m *model) selectFunc(i int) {
+	if len(m.funcs) == 0 {
+		return
+	}
+	m.fn = clamp(i, 0, len(m.funcs)-1)
+	m.srcScroll = 0
+}
+
+// ========== MENJALANKAN DEMO ==========
+
+// job adalah satu kali menjalankan demo atau satu section-nya.
+type job struct {
+	demo    int
+	section int
+	out     lockedBuffer
+	names   []string // Record.Function sesuai urutan pemanggilan
+	done    chan struct{}
+}
+
+// start membuat job baru kecuali masih ada job yang berjalan.
+func (m *model) start(d, section int) action {
+	if m.job != nil {
+		m.status = m.msg.T("tui.busy")
+		return actNone
+	}
+	m.job = &job{demo: d, section: section, done: make(chan struct{})}
+	m.last = m.job
+	m.output, m.outScroll, m.follow = nil, 0, true
+	m.funcs, m.fn, m.srcScroll = nil, 0, 0
+	return actStart
+}
+
+// run menjalankan j dan menutup j.done setelah selesai. run aman
+// dipanggil dari goroutine lain.
+func (m *model) run(j *job) {
+	defer close(j.done)
+	o := demo.NewOutput(&j.out, demo.FormatText)
+	o.SetLocale(m.cfg.Locale)
+	o.Observe(func(r demo.Record) {
+		j.names = append(j.names, r.Function)
+	})
+	d := m.demos[j.demo]
+	if j.section == 0 {
+		d.Run(o)
+	} else {
+		d.RunSection(o, j.section)
+	}
+}
+
+// refresh menyalin keluaran job yang sedang berjalan ke panel.
+func (m *model) refresh() {
+	if m.job != nil {
+		m.output = splitLines(m.job.out.String())
+	}
+}
+
+// finish dipanggil setelah m.job selesai. Panel sumber diisi dengan fungsi
+// yang dipanggil demo, lalu fungsi section itu sendiri.
+func (m *model) finish() error {
+	j := m.job
+	m.job = nil
+	m.output = splitLines(j.out.String())
+	m.follow = false
+
+	d := m.demos[j.demo]
+	names := j.names
+	for i, s := range d.Sections {
+		if j.section == 0 || j.section == i+1 {
+			names = append(names, funcName(s.Run))
+		}
+	}
+	seen := make(map[string]bool)
+	for _, name := range names {
+		s, ok := m.cfg.Sources.Lookup(name)
+		if !ok || seen[s.Name] {
+			continue
+		}
+		seen[s.Name] = true
+		m.funcs = append(m.funcs, s)
+	}
+
+	if m.cfg.OnRun != nil {
+		return m.cfg.OnRun(d.ID, j.section)
+	}
+	return nil
+}
+
+// funcName mengembalikan nama fungsi fn dalam bentuk "paket.Nama".
+func funcName(fn any) string {
+	name := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name()
+	return name[strings.LastIndexByte(name, '/')+1:]
+}
+
+func splitLines(s string) []string {
+	s = strings.TrimRight(s, "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// lockedBuffer adalah bytes.Buffer yang aman ditulis oleh demo dan dibaca
+// oleh loop tampilan secara bersamaan.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func clamp(v, lo, hi int) int {
+	return max(lo, min(v, hi))
+}
@@ -0,0 +1,242 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Gaya ANSI yang dipakai untuk satu baris penuh.
+const (
+	styleNone     = ""
+	styleBold     = "\x1b[1m"
+	styleInverse  = "\x1b[7m"
+	styleDim      = "\x1b[2m"
+	styleReset    = "\x1b[0m"
+	minWidth      = 40
+	minHeight     = 10
+	leftMaxWidth  = 30
+	sourceMinWide = 100 // di bawah lebar ini panel sumber menggantikan keluaran
+)
+
+// line adalah satu baris panel dengan satu gaya.
+type line struct {
+	text  string
+	style string
+}
+
+// render menghasilkan seluruh layar sebagai baris-baris yang sudah diberi
+// gaya. Setiap baris tepat selebar m.width kolom.
+func (m *model) render() []string {
+	w, h := m.width, m.height
+	if w < minWidth || h < minHeight {
+		rows := make([]string, h)
+		rows[0] = pad(m.msg.T("tui.too_small"), w)
+		for i := 1; i < h; i++ {
+			rows[i] = pad("", w)
+		}
+		return rows
+	}
+
+	bodyH := h - 2
+	leftW := min(leftMaxWidth, w/4+4)
+	columns := [][]line{m.leftColumn(leftW, bodyH)}
+	widths := []int{leftW}
+
+	rest := w - leftW - 1
+	switch {
+	case m.showSource && w >= sourceMinWide:
+		outW := (rest - 1) / 2
+		columns = append(columns, m.outputColumn(outW, bodyH), m.sourceColumn(rest-1-outW, bodyH))
+		widths = append(widths, outW, rest-1-outW)
+	case m.showSource && m.focus == paneSource:
+		columns = append(columns, m.sourceColumn(rest, bodyH))
+		widths = append(widths, rest)
+	default:
+		columns = append(columns, m.outputColumn(rest, bodyH))
+		widths = append(widths, rest)
+	}
+
+	rows := make([]string, 0, h)
+	rows = append(rows, styled(line{" learn-go  " + m.demos[m.demo].Title.In(m.msg.Locale()), styleInverse}, w))
+	for i := range bodyH {
+		var b strings.Builder
+		for c, col := range columns {
+			if c > 0 {
+				b.WriteString("│")
+			}
+			b.WriteString(styled(col[i], widths[c]))
+		}
+		rows = append(rows, b.String())
+	}
+	status := m.status
+	if status == "" && m.job != nil {
+		status = m.msg.Sprintf("tui.running", m.jobTitle(m.job))
+	}
+	if status == "" {
+		status = m.msg.T("tui.help")
+	}
+	rows = append(rows, styled(line{" " + status, styleInverse}, w))
+	return rows
+}
+
+// header membuat judul panel; panel yang fokus ditampilkan terbalik.
+func (m *model) header(text string, p pane) line {
+	if m.focus == p {
+		return line{" " + text, styleInverse}
+	}
+	return line{" " + text, styleBold}
+}
+
+// item membuat satu baris daftar; baris terpilih ditandai.
+func (m *model) item(text string, selected bool, p pane) line {
+	switch {
+	case selected && m.focus == p:
+		return line{"▸ " + text, styleInverse}
+	case selected:
+		return line{"▸ " + text, styleBold}
+	default:
+		return line{"  " + text, styleNone}
+	}
+}
+
+// leftColumn berisi daftar kategori lalu daftar section demo terpilih.
+func (m *model) leftColumn(w, h int) []line {
+	l := m.msg.Locale()
+	var demos []line
+	for i, d := range m.demos {
+		demos = append(demos, m.item(fmt.Sprintf("%d. %s", i+1, d.Title.In(l)), i == m.demo, paneDemos))
+	}
+	sections := []line{m.item("0. "+m.msg.T("tui.all_sections"), m.section == 0, paneSections)}
+	for i, s := range m.demos[m.demo].Sections {
+		sections = append(sections, m.item(fmt.Sprintf("%d. %s", i+1, s.Title.In(l)), m.section == i+1, paneSections))
+	}
+
+	// Daftar kategori mendapat ruang secukupnya, sisanya untuk section.
+	demosH := min(len(demos)+1, max(h/2, 3))
+	col := []line{m.header(m.msg.T("tui.demos"), paneDemos)}
+	col = append(col, window(demos, m.demo, demosH-1)...)
+	col = append(col, line{}, m.header(m.msg.T("tui.sections"), paneSections))
+	col = append(col, window(sections, m.section, h-len(col))...)
+	return fill(col, h)
+}
+
+// outputColumn berisi keluaran run terakhir yang dibungkus selebar w.
+func (m *model) outputColumn(w, h int) []line {
+	var wrapped []string
+	for _, s := range m.output {
+		wrapped = append(wrapped, wrap(s, w-1)...)
+	}
+	bodyH := h - 1
+	maxScroll := max(len(wrapped)-bodyH, 0)
+	if m.follow {
+		m.outScroll = maxScroll
+	}
+	m.outScroll = min(m.outScroll, maxScroll)
+
+	title := m.msg.T("tui.output")
+	if m.last != nil {
+		title += ": " + m.jobTitle(m.last)
+	}
+	if len(wrapped) > bodyH {
+		title += fmt.Sprintf(" [%d-%d/%d]", m.outScroll+1, min(m.outScroll+bodyH, len(wrapped)), len(wrapped))
+	}
+	col := []line{m.header(title, paneOutput)}
+	if m.last == nil {
+		col = append(col, line{" " + m.msg.T("tui.no_output"), styleDim})
+	}
+	for _, s := range wrapped[m.outScroll:min(m.outScroll+bodyH, len(wrapped))] {
+		col = append(col, line{" " + s, styleNone})
+	}
+	return fill(col, h)
+}
+
+// sourceColumn berisi kode sumber fungsi yang dipilih dengan [ dan ].
+func (m *model) sourceColumn(w, h int) []line {
+	title := m.msg.T("tui.source")
+	if len(m.funcs) == 0 {
+		col := []line{m.header(title, paneSource)}
+		if m.last != nil && m.job == nil {
+			col = append(col, line{" " + m.msg.T("tui.no_source"), styleDim})
+		}
+		return fill(col, h)
+	}
+
+	s := m.funcs[m.fn]
+	title += fmt.Sprintf(": %s [%d/%d]", s.Name, m.fn+1, len(m.funcs))
+	code := strings.Split(strings.ReplaceAll(s.Text, "\t", "    "), "\n")
+	bodyH := h - 2
+	m.srcScroll = min(m.srcScroll, max(len(code)-bodyH, 0))
+
+	col := []line{m.header(title, paneSource), {fmt.Sprintf(" %s:%d", s.File, s.Line), styleDim}}
+	for _, c := range code[m.srcScroll:min(m.srcScroll+bodyH, len(code))] {
+		col = append(col, line{" " + c, styleNone})
+	}
+	return fill(col, h)
+}
+
+// jobTitle mengembalikan judul demo dan section yang dijalankan j.
+func (m *model) jobTitle(j *job) string {
+	l := m.msg.Locale()
+	d := m.demos[j.demo]
+	if j.section == 0 {
+		return d.Title.In(l)
+	}
+	return fmt.Sprintf("%s / %d. %s", d.Title.In(l), j.section, d.Sections[j.section-1].Title.In(l))
+}
+
+// window mengembalikan paling banyak h item dengan item sel tetap terlihat.
+func window(items []line, sel, h int) []line {
+	if h <= 0 {
+		return nil
+	}
+	if len(items) <= h {
+		return items
+	}
+	start := clamp(sel-h/2, 0, len(items)-h)
+	return items[start : start+h]
+}
+
+// fill memotong atau menambah baris kosong sampai col tepat h baris.
+func fill(col []line, h int) []line {
+	for len(col) < h {
+		col = append(col, line{})
+	}
+	return col[:h]
+}
+
+// styled memberi gaya pada l setelah teksnya dipas menjadi w kolom.
+func styled(l line, w int) string {
+	if l.style == styleNone {
+		return pad(l.text, w)
+	}
+	return l.style + pad(l.text, w) + styleReset
+}
+
+// pad memotong atau menambah spasi sampai s tepat w kolom. Teks yang
+// terpotong diakhiri "…".
+func pad(s string, w int) string {
+	n := utf8.RuneCountInString(s)
+	if n <= w {
+		return s + strings.Repeat(" ", w-n)
+	}
+	if w <= 0 {
+		return ""
+	}
+	return string([]rune(s)[:w-1]) + "…"
+}
+
+// wrap memecah s menjadi beberapa baris selebar paling banyak w kolom.
+func wrap(s string, w int) []string {
+	s = strings.ReplaceAll(s, "\t", "    ")
+	r := []rune(s)
+	if len(r) <= w || w <= 0 {
+		return []string{s}
+	}
+	var out []string
+	for len(r) > w {
+		out = append(out, string(r[:w]))
+		r = r[w:]
+	}
+	return append(out, string(r))
+}
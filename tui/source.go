@@ -0,0 +1,108 @@
+package tui
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// Source adalah kode sumber satu fungsi beserta doc comment-nya.
+type Source struct {
+	Name string // misalnya "mathx.Fibonacci" atau "people.Person.GetInfo"
+	File string // path di dalam fs.FS
+	Line int    // baris pertama deklarasi
+	Text string
+}
+
+// SourceIndex mencari kode sumber fungsi berdasarkan nama yang dipakai di
+// demo.Record.Function.
+type SourceIndex struct {
+	funcs map[string]Source
+}
+
+// NewSourceIndex mem-parsing semua file .go (kecuali _test.go) di fsys.
+// File yang tidak bisa di-parse dilewati.
+func NewSourceIndex(fsys fs.FS) (*SourceIndex, error) {
+	idx := &SourceIndex{funcs: make(map[string]Source)}
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Ext(name) != ".go" || strings.HasSuffix(name, "_test.go") {
+			return err
+		}
+		src, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		idx.add(name, src)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+func (idx *SourceIndex) add(name string, src []byte) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, parser.ParseComments)
+	if err != nil {
+		return
+	}
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		key := f.Name.Name + "."
+		if fn.Recv != nil && len(fn.Recv.List) > 0 {
+			key += receiverName(fn.Recv.List[0].Type) + "."
+		}
+		key += fn.Name.Name
+
+		start := fn.Pos()
+		if fn.Doc != nil {
+			start = fn.Doc.Pos()
+		}
+		idx.funcs[key] = Source{
+			Name: key,
+			File: name,
+			Line: fset.Position(fn.Pos()).Line,
+			Text: string(src[fset.Position(start).Offset:fset.Position(fn.End()).Offset]),
+		}
+	}
+}
+
+// receiverName mengembalikan nama tipe receiver tanpa pointer dan
+// parameter tipe.
+func receiverName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(t.X)
+	case *ast.IndexExpr:
+		return receiverName(t.X)
+	case *ast.IndexListExpr:
+		return receiverName(t.X)
+	case *ast.Ident:
+		return t.Name
+	}
+	return ""
+}
+
+// Lookup mencari fungsi name. Argumen di nama record seperti
+// "functional.Multiplier(2)" diabaikan, dan nama tanpa paket dicari di paket
+// demo. Lookup pada index nil selalu gagal.
+func (idx *SourceIndex) Lookup(name string) (Source, bool) {
+	if idx == nil {
+		return Source{}, false
+	}
+	if i := strings.IndexByte(name, '('); i >= 0 {
+		name = name[:i]
+	}
+	if !strings.Contains(name, ".") {
+		name = "demo." + name
+	}
+	s, ok := idx.funcs[name]
+	return s, ok
+}
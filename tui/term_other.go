@@ -0,0 +1,22 @@
+//go:build !linux
+
+package tui
+
+import "os"
+
+const supported = false
+
+// IsTerminal selalu false di luar Linux sehingga CLI memakai menu teks.
+func IsTerminal(fd uintptr) bool {
+	return false
+}
+
+func makeRaw(fd uintptr) (restore func() error, err error) {
+	return nil, ErrUnsupported
+}
+
+func termSize(fd uintptr) (width, height int, err error) {
+	return 0, 0, ErrUnsupported
+}
+
+func notifyResize(c chan<- os.Signal) {}
@@ -0,0 +1,126 @@
+// Package tui adalah tampilan terminal layar penuh untuk menu demo: navigasi
+// kategori dengan keyboard, panel keluaran yang bisa digulung, menjalankan
+// ulang satu section, dan panel kode sumber fungsi yang didemokan. Paket
+// ini hanya memakai library standar; mode raw terminal diatur lewat ioctl
+// sehingga hanya tersedia di Linux.
+package tui
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"learn-go/i18n"
+)
+
+var (
+	// ErrUnsupported dikembalikan di sistem operasi selain Linux.
+	ErrUnsupported = errors.New("tui: hanya didukung di Linux")
+	// ErrNotTerminal dikembalikan jika stdin atau stdout bukan terminal.
+	ErrNotTerminal = errors.New("tui: stdin dan stdout harus terminal")
+)
+
+// Config mengatur tampilan.
+type Config struct {
+	Locale i18n.Locale
+	// Sources berisi kode sumber untuk panel sumber; boleh nil.
+	Sources *SourceIndex
+	// OnRun dipanggil setelah demo atau satu section selesai dijalankan
+	// (section 0 berarti semua section). Error ditampilkan di baris status.
+	OnRun func(demoID string, section int) error
+}
+
+// refreshInterval adalah jeda menggambar ulang keluaran demo yang masih
+// berjalan.
+const refreshInterval = 100 * time.Millisecond
+
+// Run menampilkan TUI di terminal in/out sampai pengguna menekan q atau
+// Ctrl+C. Mode terminal selalu dikembalikan sebelum Run selesai.
+func Run(in, out *os.File, cfg Config) error {
+	if !supported {
+		return ErrUnsupported
+	}
+	if !IsTerminal(in.Fd()) || !IsTerminal(out.Fd()) {
+		return ErrNotTerminal
+	}
+	restore, err := makeRaw(in.Fd())
+	if err != nil {
+		return err
+	}
+	defer restore()
+
+	// Layar alternatif menjaga isi terminal sebelumnya tetap utuh.
+	fmt.Fprint(out, "\x1b[?1049h\x1b[?25l")
+	defer fmt.Fprint(out, "\x1b[?25h\x1b[?1049l")
+
+	keys := make(chan []Key)
+	go readKeys(in, keys)
+	resize := make(chan os.Signal, 1)
+	notifyResize(resize)
+	defer signal.Stop(resize)
+
+	m := newModel(cfg)
+	screen := bufio.NewWriter(out)
+	draw := func() {
+		if w, h, err := termSize(out.Fd()); err == nil {
+			m.width, m.height = w, h
+		}
+		screen.WriteString("\x1b[H")
+		screen.WriteString(strings.Join(m.render(), "\r\n"))
+		screen.Flush()
+	}
+
+	var (
+		ticker *time.Ticker
+		tick   <-chan time.Time
+		done   <-chan struct{}
+	)
+	for {
+		draw()
+		select {
+		case ks, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			for _, k := range ks {
+				switch m.update(k) {
+				case actQuit:
+					return nil
+				case actStart:
+					go m.run(m.job)
+					done = m.job.done
+					ticker = time.NewTicker(refreshInterval)
+					tick = ticker.C
+				}
+			}
+		case <-resize:
+		case <-tick:
+			m.refresh()
+		case <-done:
+			ticker.Stop()
+			tick, done = nil, nil
+			if err := m.finish(); err != nil {
+				m.status = err.Error()
+			}
+		}
+	}
+}
+
+// readKeys membaca tombol dari in sampai terjadi error, lalu menutup keys.
+func readKeys(in *os.File, keys chan<- []Key) {
+	defer close(keys)
+	buf := make([]byte, 64)
+	for {
+		n, err := in.Read(buf)
+		if n > 0 {
+			keys <- parseKeys(buf[:n])
+		}
+		if err != nil {
+			return
+		}
+	}
+}
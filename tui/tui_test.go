@@ -0,0 +1,144 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"learn-go/i18n"
+)
+
+func TestParseKeys(t *testing.T) {
+	got := parseKeys([]byte("\x1b[A\x1b[6~j\r\x1b\x1b[1;5C\tä"))
+	want := []Key{
+		{Code: KeyUp},
+		{Code: KeyPageDown},
+		{Code: KeyRune, Rune: 'j'},
+		{Code: KeyEnter},
+		{Code: KeyEsc},
+		// Ctrl+→ tidak dikenal dan diabaikan.
+		{Code: KeyTab},
+		{Code: KeyRune, Rune: 'ä'},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("parseKeys = %v\nwant %v", got, want)
+	}
+}
+
+func newTestIndex(t *testing.T) *SourceIndex {
+	t.Helper()
+	idx, err := NewSourceIndex(os.DirFS(".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return idx
+}
+
+func TestSourceLookup(t *testing.T) {
+	idx := newTestIndex(t)
+	for _, tt := range []struct{ name, want string }{
+		{"mathx.Fibonacci", "func Fibonacci("},
+		{"functional.Multiplier(2)", "func Multiplier("},
+		{"people.Person.GetInfo", ") GetInfo("},
+		{"criticalOperation", "func criticalOperation("},
+	} {
+		s, ok := idx.Lookup(tt.name)
+		if !ok || !strings.Contains(s.Text, tt.want) || s.Line == 0 {
+			t.Errorf("Lookup(%q) = %+v, %v", tt.name, s, ok)
+		}
+	}
+	if s, ok := idx.Lookup("os.Open"); ok {
+		t.Errorf("Lookup(os.Open) = %+v", s)
+	}
+}
+
+// runJob menjalankan job m secara sinkron seperti loop utama.
+func runJob(t *testing.T, m *model, k Key) {
+	t.Helper()
+	if m.update(k) != actStart {
+		t.Fatalf("update(%v) tidak memulai job", k)
+	}
+	m.run(m.job)
+	if err := m.finish(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+var ansi = regexp.MustCompile(`\x1b\[[0-9;?]*[a-zA-Z]`)
+
+func TestRunSection(t *testing.T) {
+	var runs []string
+	m := newModel(Config{
+		Locale:  i18n.English,
+		Sources: newTestIndex(t),
+		OnRun: func(id string, section int) error {
+			runs = append(runs, fmt.Sprintf("%s/%d", id, section))
+			return nil
+		},
+	})
+	m.width, m.height = 120, 30
+
+	// Pilih demo "recursive" lalu jalankan section 1 dengan tombol angka.
+	for range 2 {
+		m.update(Key{Code: KeyDown})
+	}
+	runJob(t, m, Key{Code: KeyRune, Rune: '1'})
+	if !slices.Equal(runs, []string{"recursive/1"}) {
+		t.Errorf("OnRun = %v", runs)
+	}
+	if len(m.output) == 0 || !strings.HasPrefix(m.output[0], "1. ") {
+		t.Errorf("output = %q", m.output)
+	}
+	if len(m.funcs) == 0 || m.funcs[0].Name != "mathx.Factorial" {
+		t.Errorf("funcs[0] = %+v", m.funcs)
+	}
+	if last := m.funcs[len(m.funcs)-1].Name; !strings.HasPrefix(last, "demo.") {
+		t.Errorf("fungsi section = %s", last)
+	}
+
+	// r mengulang run terakhir.
+	runJob(t, m, Key{Code: KeyRune, Rune: 'r'})
+	if len(runs) != 2 || runs[1] != "recursive/1" {
+		t.Errorf("OnRun setelah r = %v", runs)
+	}
+
+	if m.update(Key{Code: KeyRune, Rune: '9'}) != actNone || m.status == "" {
+		t.Errorf("section 9 seharusnya ditolak, status %q", m.status)
+	}
+
+	for _, size := range [][2]int{{120, 30}, {80, 24}, {30, 5}} {
+		m.width, m.height = size[0], size[1]
+		rows := m.render()
+		if len(rows) != m.height {
+			t.Errorf("%v: %d baris", size, len(rows))
+		}
+		for i, r := range rows {
+			if n := utf8.RuneCountInString(ansi.ReplaceAllString(r, "")); n != m.width {
+				t.Errorf("%v: baris %d selebar %d", size, i, n)
+			}
+		}
+	}
+}
+
+func TestScrollOutput(t *testing.T) {
+	m := newModel(Config{Locale: i18n.Indonesian})
+	m.width, m.height = 100, 12
+	m.output = strings.Split(strings.Repeat("baris\n", 50), "\n")
+	m.focus = paneOutput
+
+	m.update(Key{Code: KeyEnd})
+	m.render()
+	if m.outScroll != len(m.output)-(m.height-3) {
+		t.Errorf("End: outScroll = %d", m.outScroll)
+	}
+	m.update(Key{Code: KeyPageUp})
+	m.update(Key{Code: KeyHome})
+	m.render()
+	if m.outScroll != 0 {
+		t.Errorf("Home: outScroll = %d", m.outScroll)
+	}
+}
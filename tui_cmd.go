@@ -0,0 +1,77 @@
+package main
+
+import (
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"learn-go/tui"
+)
+
+// sources adalah kode sumber semua paket di modul ini, ditampilkan di panel
+// sumber TUI. Paket baru ikut otomatis tanpa mengubah daftar ini.
+//
+// go:embed tidak bisa mengecualikan file, jadi pola di bawah memilih file
+// .go yang namanya tidak berakhiran "_test": huruf sebelum ".go" bukan "t",
+// atau "t" yang tidak didahului "s". File yang berakhiran "st.go" (misalnya
+// list.go) butuh pola tambahan; TestSourcesEmbedded gagal jika ada file
+// yang terlewat.
+//
+//go:embed */*[^t].go */*[^s]t.go
+var sources embed.FS
+
+// terminal mengembalikan stdin dan stdout jika keduanya terminal, yaitu
+// saat TUI bisa dipakai.
+func (a *app) terminal() (in, out *os.File, ok bool) {
+	in, inOK := a.stdin.(*os.File)
+	out, outOK := a.stdout.(*os.File)
+	if !inOK || !outOK || !tui.IsTerminal(in.Fd()) || !tui.IsTerminal(out.Fd()) {
+		return nil, nil, false
+	}
+	return in, out, true
+}
+
+func (a *app) runTUI(args []string) int {
+	fs := a.newFlagSet("tui", func(fs *flag.FlagSet) {
+		fmt.Fprintln(fs.Output(), a.msg.T("cli.tui.usage"))
+		fs.PrintDefaults()
+	})
+	if code, ok := a.parse(fs, args); !ok {
+		return code
+	}
+	if fs.NArg() != 0 {
+		fs.Usage()
+		return exitUsage
+	}
+	in, inOK := a.stdin.(*os.File)
+	out, outOK := a.stdout.(*os.File)
+	if !inOK || !outOK {
+		fmt.Fprintf(a.stderr, "learn-go tui: %v\n", tui.ErrNotTerminal)
+		return exitFail
+	}
+	if err := a.tui(in, out); err != nil {
+		fmt.Fprintf(a.stderr, "learn-go tui: %v\n", err)
+		return exitFail
+	}
+	return exitOK
+}
+
+func (a *app) tui(in, out *os.File) error {
+	idx, err := tui.NewSourceIndex(sources)
+	if err != nil {
+		return err
+	}
+	return tui.Run(in, out, tui.Config{
+		Locale:  a.msg.Locale(),
+		Sources: idx,
+		OnRun: func(id string, section int) error {
+			if err := a.saveRun(id, section); err != nil {
+				return errors.New(strings.TrimSpace(a.msg.Sprintf("progress.save_failed", err)))
+			}
+			return nil
+		},
+	})
+}
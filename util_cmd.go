@@ -0,0 +1,134 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"learn-go/mathx"
+	"learn-go/textutil"
+	"learn-go/timeutil"
+	"learn-go/validate"
+)
+
+// utilCommand memetakan satu nama subcommand `util` ke fungsi paket.
+type utilCommand struct {
+	name  string
+	args  string // kunci katalog nama argumen untuk pesan bantuan
+	nargs int
+	help  string // kunci katalog pesan bantuan
+	run   func(args []string) (any, error)
+}
+
+var utilCommands = []utilCommand{
+	{"nik", "util.arg.nik", 1, "util.help.nik", func(a []string) (any, error) {
+		return validate.IsValidNIK(a[0]), nil
+	}},
+	{"phone", "util.arg.phone", 1, "util.help.phone", func(a []string) (any, error) {
+		return validate.IsValidPhoneNumber(a[0]), nil
+	}},
+	{"email", "util.arg.email", 1, "util.help.email", func(a []string) (any, error) {
+		return validate.IsValidEmailRegex(a[0]), nil
+	}},
+	{"zip-code", "util.arg.zip_code", 1, "util.help.zip-code", func(a []string) (any, error) {
+		return validate.IsValidZipCode(a[0]), nil
+	}},
+	{"format-number", "util.arg.number", 1, "util.help.format-number", func(a []string) (any, error) {
+		n, err := strconv.Atoi(a[0])
+		if err != nil {
+			return nil, err
+		}
+		return textutil.FormatNumber(n), nil
+	}},
+	{"bytes", "util.arg.bytes", 1, "util.help.bytes", func(a []string) (any, error) {
+		n, err := strconv.ParseInt(a[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return textutil.BytesToHuman(n), nil
+	}},
+	{"prime", "util.arg.number", 1, "util.help.prime", func(a []string) (any, error) {
+		n, err := strconv.Atoi(a[0])
+		if err != nil {
+			return nil, err
+		}
+		return mathx.IsPrime(n), nil
+	}},
+	{"leap-year", "util.arg.year", 1, "util.help.leap-year", func(a []string) (any, error) {
+		n, err := strconv.Atoi(a[0])
+		if err != nil {
+			return nil, err
+		}
+		return timeutil.IsLeapYear(n), nil
+	}},
+	{"celsius", "util.arg.temperature", 1, "util.help.celsius", func(a []string) (any, error) {
+		c, err := strconv.ParseFloat(a[0], 64)
+		if err != nil {
+			return nil, err
+		}
+		return mathx.CelsiusToFahrenheit(c), nil
+	}},
+	{"palindrome", "util.arg.text", 1, "util.help.palindrome", func(a []string) (any, error) {
+		return textutil.IsPalindrome(a[0]), nil
+	}},
+	{"title-case", "util.arg.text", 1, "util.help.title-case", func(a []string) (any, error) {
+		return textutil.ToTitleCase(a[0]), nil
+	}},
+	{"clean", "util.arg.text", 1, "util.help.clean", func(a []string) (any, error) {
+		return textutil.CleanString(a[0]), nil
+	}},
+	{"reverse", "util.arg.text", 1, "util.help.reverse", func(a []string) (any, error) {
+		return textutil.Reverse(a[0]), nil
+	}},
+	{"word-count", "util.arg.text", 1, "util.help.word-count", func(a []string) (any, error) {
+		return textutil.CountWords(a[0]), nil
+	}},
+}
+
+func findUtilCommand(name string) (utilCommand, bool) {
+	for _, c := range utilCommands {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return utilCommand{}, false
+}
+
+func (a *app) runUtil(args []string) int {
+	fs := a.newFlagSet("util", func(fs *flag.FlagSet) {
+		fmt.Fprintln(fs.Output(), a.msg.T("cli.util.usage"))
+		for _, c := range utilCommands {
+			fmt.Fprintf(fs.Output(), "  %-28s %s\n", c.name+" "+a.msg.T(c.args), a.msg.T(c.help))
+		}
+	})
+	if code, ok := a.parse(fs, args); !ok {
+		return code
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	c, ok := findUtilCommand(fs.Arg(0))
+	if !ok {
+		fmt.Fprint(a.stderr, a.msg.Sprintf("cli.util.unknown", fs.Arg(0)))
+		return exitUsage
+	}
+	rest := fs.Args()[1:]
+	if len(rest) != c.nargs {
+		fmt.Fprint(a.stderr, a.msg.Sprintf("cli.util.command_usage", c.name, a.msg.T(c.args)))
+		return exitUsage
+	}
+
+	result, err := c.run(rest)
+	if err != nil {
+		fmt.Fprint(a.stderr, a.msg.Sprintf("cli.util.invalid_arg", c.name, strings.Join(rest, " "), err))
+		return exitUsage
+	}
+	fmt.Fprintln(a.stdout, result)
+	if ok, isBool := result.(bool); isBool && !ok {
+		return exitFail
+	}
+	return exitOK
+}
@@ -0,0 +1,66 @@
+package validate
+
+import (
+	"errors"
+
+	"learn-go/apperr"
+)
+
+// ========== KUMPULAN PELANGGARAN ==========
+
+// Nama aturan yang dicatat di apperr.Field.Rule.
+const (
+	RuleRequired    = "required"
+	RuleAgeRange    = "age_range"
+	RuleEmail       = "email"
+	RuleZipCode     = "zip_code"
+	RuleNonNegative = "non_negative"
+)
+
+// Collector mengumpulkan semua pelanggaran validasi lalu mengembalikannya
+// sebagai satu error, sehingga pemanggil melihat semua field yang salah
+// sekaligus, bukan hanya yang pertama. Nilai nol siap dipakai.
+type Collector struct {
+	fields []apperr.Field
+}
+
+// Check mencatat pelanggaran rule pada field jika ok false, lalu
+// mengembalikan ok.
+func (c *Collector) Check(ok bool, field, rule, msg string) bool {
+	if !ok {
+		c.fields = append(c.fields, apperr.Field{Name: field, Rule: rule, Message: msg})
+	}
+	return ok
+}
+
+// Merge menyalin pelanggaran dari err, hasil Err milik Collector lain,
+// dengan awalan prefix pada nama field, misalnya "Address" menjadi
+// "Address.ZipCode". Error lain dicatat sebagai satu pelanggaran pada
+// prefix.
+func (c *Collector) Merge(prefix string, err error) {
+	if err == nil {
+		return
+	}
+	var e *apperr.Error
+	if !errors.As(err, &e) || len(e.Fields) == 0 {
+		c.fields = append(c.fields, apperr.Field{Name: prefix, Message: err.Error()})
+		return
+	}
+	for _, f := range e.Fields {
+		f.Name = prefix + "." + f.Name
+		c.fields = append(c.fields, f)
+	}
+}
+
+// Err mengembalikan nil jika tidak ada pelanggaran, atau *apperr.Error
+// berkode apperr.CodeValidation yang Fields-nya berisi semua pelanggaran.
+func (c *Collector) Err() error {
+	if len(c.fields) == 0 {
+		return nil
+	}
+	return &apperr.Error{
+		Code:    apperr.CodeValidation,
+		Message: "validasi gagal",
+		Fields:  append([]apperr.Field(nil), c.fields...),
+	}
+}
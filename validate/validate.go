@@ -0,0 +1,62 @@
+// Package validate berisi fungsi validasi untuk data yang umum dipakai di
+// Indonesia: email, nomor telepon, NIK dan kode pos, serta Collector untuk
+// mengumpulkan pelanggaran dari banyak field.
+package validate
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	emailRe    = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+	nonDigitRe = regexp.MustCompile(`[^\d]`)
+	zipCodeRe  = regexp.MustCompile(`^[1-9][0-9]{4}$`)
+)
+
+// IsValidEmail melakukan validasi email sederhana.
+func IsValidEmail(email string) bool {
+	return strings.Contains(email, "@") && strings.Contains(email, ".")
+}
+
+// IsValidEmailRegex melakukan validasi email dengan regex.
+func IsValidEmailRegex(email string) bool {
+	return emailRe.MatchString(email)
+}
+
+// IsValidPhoneNumber memvalidasi nomor telepon Indonesia yang diawali
+// +62/62 atau 08.
+func IsValidPhoneNumber(phone string) bool {
+	// Hapus semua karakter selain digit
+	cleaned := nonDigitRe.ReplaceAllString(phone, "")
+
+	if strings.HasPrefix(cleaned, "62") && len(cleaned) >= 11 && len(cleaned) <= 13 {
+		return true
+	}
+	if strings.HasPrefix(cleaned, "08") && len(cleaned) >= 10 && len(cleaned) <= 12 {
+		return true
+	}
+	return false
+}
+
+// IsValidNIK memvalidasi NIK (Nomor Induk Kependudukan) yang terdiri dari
+// 16 digit.
+func IsValidNIK(nik string) bool {
+	if len(nik) != 16 {
+		return false
+	}
+
+	for _, char := range nik {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsValidZipCode memvalidasi kode pos Indonesia: 5 digit yang tidak
+// diawali 0.
+func IsValidZipCode(zip string) bool {
+	return zipCodeRe.MatchString(zip)
+}
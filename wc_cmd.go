@@ -0,0 +1,104 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"learn-go/fileproc"
+)
+
+// maxWarnings adalah jumlah peringatan per file yang ditampilkan wc; sisanya
+// hanya disebutkan jumlahnya agar file biner tidak membanjiri stderr.
+const maxWarnings = 10
+
+// runWC menghitung baris, kata dan byte setiap file seperti wc. Tanpa file
+// atau dengan "-", input dibaca dari stdin. File yang gagal diproses
+// dilaporkan ke stderr dan file lainnya tetap diproses. Baris yang bukan
+// UTF-8 valid tetap dihitung dan hanya dilaporkan sebagai peringatan.
+func (a *app) runWC(args []string) int {
+	fs := a.newFlagSet("wc", func(fs *flag.FlagSet) {
+		fmt.Fprint(fs.Output(), a.msg.T("cli.wc.usage"))
+		fs.PrintDefaults()
+	})
+	human := fs.Bool("human", false, a.msg.T("cli.flag.human"))
+	top := fs.Int("top", 0, a.msg.T("cli.flag.top"))
+	if code, ok := a.parse(fs, args); !ok {
+		return code
+	}
+	if *top < 0 {
+		fmt.Fprint(a.stderr, a.msg.Sprintf("cli.wc.invalid_top", *top))
+		return exitUsage
+	}
+	// Frekuensi kata hanya dibangun jika diminta, karena ukurannya
+	// sebanding dengan jumlah kata unik di input.
+	opts := fileproc.Options{Freq: *top > 0}
+
+	files := fs.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+
+	code := exitOK
+	var total fileproc.Counts
+	for _, name := range files {
+		var c fileproc.Counts
+		var err error
+		if name == "-" {
+			c, err = fileproc.Count("-", a.stdin, opts)
+		} else {
+			c, err = fileproc.File(name, opts, nil)
+		}
+		if err != nil {
+			fmt.Fprintf(a.stderr, "learn-go wc: %v\n", err)
+			code = exitFail
+			continue
+		}
+		a.printWarnings(c.Warnings, name)
+		a.printCounts(c, name, *human)
+		total = total.Add(c)
+	}
+	if len(files) > 1 {
+		a.printCounts(total, a.msg.T("wc.total"), *human)
+	}
+
+	if words := total.Top(*top); len(words) > 0 {
+		fmt.Fprintln(a.stdout)
+		fmt.Fprintln(a.stdout, a.msg.T("wc.top"))
+		for i, w := range words {
+			fmt.Fprintf(a.stdout, "%3d. %-20s %d\n", i+1, w.Word, w.Count)
+		}
+	}
+	return code
+}
+
+// printWarnings menulis paling banyak maxWarnings peringatan ke stderr.
+func (a *app) printWarnings(warnings []*fileproc.PosError, name string) {
+	for _, w := range warnings[:min(len(warnings), maxWarnings)] {
+		fmt.Fprint(a.stderr, a.msg.Sprintf("cli.wc.warning", w))
+	}
+	if n := len(warnings) - maxWarnings; n > 0 {
+		fmt.Fprintf(a.stderr, a.msg.N("cli.wc.more", n), name, n)
+	}
+}
+
+// printCounts menulis satu baris "baris kata byte nama", ditambah
+// keterangan kompresi dan encoding jika bukan UTF-8 biasa.
+func (a *app) printCounts(c fileproc.Counts, name string, human bool) {
+	size := strconv.FormatInt(c.Bytes, 10)
+	if human {
+		size = c.HumanBytes()
+	}
+	var notes []string
+	if c.Compressed {
+		notes = append(notes, "gzip")
+	}
+	if c.Encoding != "" && c.Encoding != fileproc.UTF8 {
+		notes = append(notes, string(c.Encoding))
+	}
+	if len(notes) > 0 {
+		name += " (" + strings.Join(notes, ", ") + ")"
+	}
+	fmt.Fprintf(a.stdout, "%8d %8d %10s %s\n", c.Lines, c.Words, size, name)
+}